@@ -0,0 +1,303 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/JoLandry/linalgo/vector"
+)
+
+// Banded is a square matrix whose non-zero elements are all within kl diagonals
+// below the main diagonal and ku diagonals above it.
+//
+// Only the band is stored, which takes O(n * (kl + ku)) memory: each row holds
+// kl + ku + 1 elements, the element (i, j) being at index i*(kl+ku+1) + j-i+kl.
+type Banded struct {
+	n    int
+	kl   int
+	ku   int
+	data []float64
+}
+
+// BandedLU holds the LU factorization with partial pivoting of a banded matrix.
+//
+// The factorization satisfies P * A = L * U, where L is unit lower triangular with
+// kl subdiagonals and U is upper triangular with kl + ku superdiagonals, since row
+// interchanges widen the upper band. It costs O(n * kl * (kl + ku)) operations,
+// and solving a system then costs O(n * (kl + ku)).
+type BandedLU struct {
+	// Band storage with kl subdiagonals (multipliers of L) and kl + ku superdiagonals (U)
+	lu *Banded
+	// pivot[k] is the row swapped with row k at step k
+	pivot []int
+	// Sign of the permutation (+1 or -1)
+	sign float64
+}
+
+// Creates and returns a zero n x n banded matrix with kl subdiagonals and ku superdiagonals.
+//
+// It panics if a dimension or a bandwidth is negative.
+func NewBanded(n, kl, ku int) *Banded {
+	if n < 0 || kl < 0 || ku < 0 {
+		panic("size and bandwidths of a banded matrix must be non-negative")
+	}
+
+	return &Banded{n: n, kl: kl, ku: ku, data: make([]float64, n*(kl+ku+1))}
+}
+
+// Creates and returns the banded matrix with kl subdiagonals and ku superdiagonals
+// holding the elements of a dense matrix.
+//
+// Returns an error if the matrix is not square, or if it has a non-zero element outside of the band.
+func NewBandedFromDense(m *Matrix, kl, ku int) (*Banded, error) {
+	if !m.IsSquare() {
+		return nil, fmt.Errorf("cannot build a banded matrix from a non-square matrix")
+	}
+
+	result := NewBanded(m.nbRows, kl, ku)
+	for i := 0; i < m.nbRows; i++ {
+		for j, val := range m.row(i) {
+			if result.inBand(i, j) {
+				result.data[result.index(i, j)] = val
+			} else if val != 0.0 {
+				return nil, fmt.Errorf("non-zero element at (%d, %d) outside of the band (%d, %d)", i, j, kl, ku)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// Tells whether the element (i, j) is within the band.
+func (b *Banded) inBand(i, j int) bool {
+	return j-i >= -b.kl && j-i <= b.ku
+}
+
+// Returns the index of the element (i, j) of the band in data.
+func (b *Banded) index(i, j int) int {
+	return i*(b.kl+b.ku+1) + j - i + b.kl
+}
+
+// Get the size (number of rows and columns) of the matrix
+func (b *Banded) GetSize() int {
+	return b.n
+}
+
+// Returns the number of subdiagonals and the number of superdiagonals of the band.
+func (b *Banded) Bandwidths() (int, int) {
+	return b.kl, b.ku
+}
+
+// Returns the number of rows and the number of columns of the matrix.
+func (b *Banded) Dims() (int, int) {
+	return b.n, b.n
+}
+
+// Get the element at row row and column col, zero outside of the band
+func (b *Banded) GetElementAt(row int, col int) float64 {
+	if !b.inBand(row, col) {
+		return 0.0
+	}
+	return b.data[b.index(row, col)]
+}
+
+// Set the element called elt at row row and column col
+//
+// It panics if the position is outside of the band.
+func (b *Banded) SetElementAt(row int, col int, elt float64) {
+	if !b.inBand(row, col) {
+		panic(fmt.Sprintf("position (%d, %d) is outside of the band (%d, %d)", row, col, b.kl, b.ku))
+	}
+	b.data[b.index(row, col)] = elt
+}
+
+// Returns the range of columns [start, end) of the band on row i.
+func (b *Banded) colRange(i int) (int, int) {
+	return max(0, i-b.kl), min(b.n, i+b.ku+1)
+}
+
+// Returns the matrix as a dense matrix.
+func (b *Banded) ToDense() *Matrix {
+	result := New(b.n, b.n)
+	for i := 0; i < b.n; i++ {
+		start, end := b.colRange(i)
+		row := result.row(i)
+		for j := start; j < end; j++ {
+			row[j] = b.data[b.index(i, j)]
+		}
+	}
+
+	return result
+}
+
+// Performs the product between the matrix and a vector, in O(n * (kl + ku)) operations.
+//
+// Returns an error if the size of the vector does not match the size of the matrix.
+func (b *Banded) MulVec(v *vector.Vector) (*vector.Vector, error) {
+	result := vector.New(b.n)
+	if err := b.Apply(v, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Stores the product between the matrix and x into y, without allocating.
+//
+// Returns an error if the dimensions do not agree or if y is x.
+func (b *Banded) Apply(x, y *vector.Vector) error {
+	if err := checkApply(x, y, b.n, b.n); err != nil {
+		return err
+	}
+
+	values, result := x.GetData(), y.GetData()
+	for i := 0; i < b.n; i++ {
+		start, end := b.colRange(i)
+		sum := 0.0
+		for j := start; j < end; j++ {
+			sum += b.data[b.index(i, j)] * values[j]
+		}
+		result[i] = sum
+	}
+
+	return nil
+}
+
+// Computes and returns the LU factorization with partial pivoting of the banded matrix.
+//
+// Singular matrices are factorized as well, use IsSingular on the result to check them.
+// The original matrix is not modified.
+func (b *Banded) LU() *BandedLU {
+	n, kl, ku := b.n, b.kl, b.ku
+	// Room for the kl extra superdiagonals created by row interchanges
+	lu := NewBanded(n, kl, kl+ku)
+	for i := 0; i < n; i++ {
+		start, end := b.colRange(i)
+		for j := start; j < end; j++ {
+			lu.data[lu.index(i, j)] = b.data[b.index(i, j)]
+		}
+	}
+	pivot := make([]int, n)
+	sign := 1.0
+
+	for k := 0; k < n; k++ {
+		lastRow := min(n-1, k+kl)
+		lastCol := min(n-1, k+kl+ku)
+
+		// Find pivot element (max absolute value in column k at or below row k)
+		p := k
+		maxVal := math.Abs(lu.data[lu.index(k, k)])
+		for r := k + 1; r <= lastRow; r++ {
+			if math.Abs(lu.data[lu.index(r, k)]) > maxVal {
+				maxVal = math.Abs(lu.data[lu.index(r, k)])
+				p = r
+			}
+		}
+		pivot[k] = p
+		if p != k {
+			for c := k; c <= lastCol; c++ {
+				ik, ip := lu.index(k, c), lu.index(p, c)
+				lu.data[ik], lu.data[ip] = lu.data[ip], lu.data[ik]
+			}
+			sign = -sign
+		}
+		// Nothing to eliminate in this column
+		pivotVal := lu.data[lu.index(k, k)]
+		if pivotVal == 0.0 {
+			continue
+		}
+		// Eliminate below, storing the multipliers in place
+		for r := k + 1; r <= lastRow; r++ {
+			factor := lu.data[lu.index(r, k)] / pivotVal
+			lu.data[lu.index(r, k)] = factor
+			if factor == 0.0 {
+				continue
+			}
+			for c := k + 1; c <= lastCol; c++ {
+				lu.data[lu.index(r, c)] -= factor * lu.data[lu.index(k, c)]
+			}
+		}
+	}
+
+	return &BandedLU{lu: lu, pivot: pivot, sign: sign}
+}
+
+// Solves the system B * x = v with the banded LU factorization.
+//
+// Returns an error if the size of v does not match the size of the matrix,
+// or an error wrapping ErrSingular if the matrix is singular.
+func (b *Banded) Solve(v *vector.Vector) (*vector.Vector, error) {
+	return b.LU().Solve(v)
+}
+
+// Tells whether the factorized matrix is singular, namely
+// whether one of the pivots is (numerically) zero.
+//
+// By convention, an empty (0x0) matrix is considered singular.
+func (f *BandedLU) IsSingular() bool {
+	n := f.lu.n
+	if n == 0 {
+		return true
+	}
+	for k := 0; k < n; k++ {
+		if math.Abs(f.lu.data[f.lu.index(k, k)]) < pivotTolerance {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns the determinant of the factorized matrix, computed as the
+// product of the diagonal of U times the sign of the permutation.
+//
+// By convention, the determinant of an empty (0x0) matrix is zero.
+func (f *BandedLU) Determinant() float64 {
+	n := f.lu.n
+	if n == 0 {
+		return 0.0
+	}
+	determinant := f.sign
+	for k := 0; k < n; k++ {
+		determinant *= f.lu.data[f.lu.index(k, k)]
+	}
+
+	return determinant
+}
+
+// Solves A * x = v, where A is the factorized matrix.
+//
+// Returns an error if the size of v does not match the size of A,
+// or an error wrapping ErrSingular if A is singular.
+func (f *BandedLU) Solve(v *vector.Vector) (*vector.Vector, error) {
+	lu := f.lu
+	n := lu.n
+	if v.GetSize() != n {
+		return nil, fmt.Errorf("mismatch between size of factorized matrix (%d) and size of right-hand side (%d)", n, v.GetSize())
+	}
+	if f.IsSingular() {
+		return nil, fmt.Errorf("%w, cannot solve", ErrSingular)
+	}
+
+	x := vector.NewFromData(v.GetData())
+	values := x.GetData()
+	// Apply the interchanges and L, in the order of the elimination
+	for k := 0; k < n; k++ {
+		if p := f.pivot[k]; p != k {
+			values[k], values[p] = values[p], values[k]
+		}
+		for r := k + 1; r <= min(n-1, k+lu.kl); r++ {
+			values[r] -= lu.data[lu.index(r, k)] * values[k]
+		}
+	}
+	// Back substitution with U
+	for i := n - 1; i >= 0; i-- {
+		sum := values[i]
+		for j := i + 1; j <= min(n-1, i+lu.ku); j++ {
+			sum -= lu.data[lu.index(i, j)] * values[j]
+		}
+		values[i] = sum / lu.data[lu.index(i, i)]
+	}
+
+	return x, nil
+}
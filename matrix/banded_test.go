@@ -0,0 +1,127 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/JoLandry/linalgo/vector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Pentadiagonal-like test matrix with 2 subdiagonals and 1 superdiagonal
+func newTestBanded(n int) *Banded {
+	b := NewBanded(n, 2, 1)
+	for i := 0; i < n; i++ {
+		b.SetElementAt(i, i, float64(i%3)-1)
+		if i > 0 {
+			b.SetElementAt(i, i-1, 2)
+		}
+		if i > 1 {
+			b.SetElementAt(i, i-2, float64(i))
+		}
+		if i < n-1 {
+			b.SetElementAt(i, i+1, -3)
+		}
+	}
+	return b
+}
+
+func TestBanded_Storage(t *testing.T) {
+	b := NewBanded(4, 1, 2)
+
+	b.SetElementAt(3, 2, 7)
+	b.SetElementAt(0, 2, 5)
+	kl, ku := b.Bandwidths()
+
+	assert.Equal(t, 4, b.GetSize())
+	assert.Equal(t, 1, kl)
+	assert.Equal(t, 2, ku)
+	assert.Len(t, b.data, 16)
+	assert.Equal(t, 7.0, b.GetElementAt(3, 2))
+	assert.Equal(t, 0.0, b.GetElementAt(3, 0))
+	assert.Equal(t, [][]float64{{0, 0, 5, 0}, {0, 0, 0, 0}, {0, 0, 0, 0}, {0, 0, 7, 0}}, b.ToDense().GetData())
+	assert.Panics(t, func() { b.SetElementAt(0, 3, 1) })
+}
+
+func TestNewBandedFromDense(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{1, 2, 0},
+		{3, 4, 5},
+		{0, 6, 7},
+	})
+
+	b, err := NewBandedFromDense(m, 1, 1)
+	_, errOutside := NewBandedFromDense(m, 0, 1)
+	_, errNonSquare := NewBandedFromDense(New(2, 3), 1, 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, m.GetData(), b.ToDense().GetData())
+	assert.ErrorContains(t, errOutside, "outside of the band")
+	assert.Error(t, errNonSquare)
+}
+
+func TestBanded_MulVec(t *testing.T) {
+	b := newTestBanded(6)
+	v := vector.NewFromData([]float64{1, -1, 2, 0, 3, 1})
+	expected, _ := b.ToDense().MulVec(v)
+
+	product, err := b.MulVec(v)
+
+	require.NoError(t, err)
+	assert.Equal(t, expected.GetData(), product.GetData())
+	_, err = b.MulVec(vector.New(5))
+	assert.Error(t, err)
+}
+
+func TestBanded_LU_MatchesDense(t *testing.T) {
+	b := newTestBanded(8)
+	dense := b.ToDense()
+	v := vector.NewFromData([]float64{1, 2, 3, 4, 5, 6, 7, 8})
+	expected, err := dense.Solve(v)
+	require.NoError(t, err)
+	expectedDet, _ := dense.Determinant()
+
+	lu := b.LU()
+	x, err := lu.Solve(v)
+
+	require.NoError(t, err)
+	assert.False(t, lu.IsSingular())
+	assert.InDeltaSlice(t, expected.GetData(), x.GetData(), 1e-9)
+	assert.InDelta(t, expectedDet, lu.Determinant(), 1e-9*math.Abs(expectedDet))
+}
+
+func TestBanded_Solve_Large(t *testing.T) {
+	n := 5000
+	b := newTestBanded(n)
+	for i := 0; i < n; i++ {
+		b.SetElementAt(i, i, float64(i)+10)
+	}
+	expected := vector.New(n)
+	for i := 0; i < n; i++ {
+		expected.SetElementAt(i, float64(i%4)-1.5)
+	}
+	rhs, _ := b.MulVec(expected)
+
+	x, err := b.Solve(rhs)
+
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, expected.GetData(), x.GetData(), 1e-6)
+}
+
+func TestBandedLU_Singular(t *testing.T) {
+	b := NewBanded(3, 1, 1)
+	b.SetElementAt(0, 0, 1)
+	b.SetElementAt(1, 1, 1)
+
+	lu := b.LU()
+	_, err := lu.Solve(vector.New(3))
+	_, errSize := lu.Solve(vector.New(2))
+
+	assert.True(t, lu.IsSingular())
+	assert.Equal(t, 0.0, lu.Determinant())
+	assert.True(t, errors.Is(err, ErrSingular))
+	assert.Error(t, errSize)
+	assert.True(t, NewBanded(0, 1, 1).LU().IsSingular())
+}
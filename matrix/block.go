@@ -0,0 +1,183 @@
+package matrix
+
+import (
+	"fmt"
+
+	"github.com/JoLandry/linalgo/vector"
+)
+
+// Returns the Kronecker product of a and b, namely the block matrix
+// whose block (i, j) is a[i][j] * b.
+//
+// If a is m x n and b is p x q, the result is (m*p) x (n*q).
+func Kron[T vector.Float](a, b *MatrixOf[T]) *MatrixOf[T] {
+	result := NewOf[T](a.nbRows*b.nbRows, a.nbCols*b.nbCols)
+	for i := 0; i < a.nbRows; i++ {
+		for j, scalar := range a.row(i) {
+			for k := 0; k < b.nbRows; k++ {
+				dstRow := result.row(i*b.nbRows + k)[j*b.nbCols : (j+1)*b.nbCols]
+				for l, val := range b.row(k) {
+					dstRow[l] = scalar * val
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+// Returns the block matrix made of the given blocks, where blocks[i][j]
+// is the block at block row i and block column j.
+//
+// All the blocks of a block row must have the same number of rows, and all
+// the blocks of a block column the same number of columns. An error is returned
+// otherwise, or if the block rows do not have the same number of blocks,
+// or if a block is nil. The elements are copied.
+// No block (or only empty block rows) gives an empty (0x0) matrix.
+func Block[T vector.Float](blocks [][]*MatrixOf[T]) (*MatrixOf[T], error) {
+	if len(blocks) == 0 || len(blocks[0]) == 0 {
+		for i, blockRow := range blocks {
+			if len(blockRow) != 0 {
+				return nil, fmt.Errorf("block row %d has %d blocks, expected 0 as block row 0", i, len(blockRow))
+			}
+		}
+		return NewOf[T](0, 0), nil
+	}
+
+	heights := make([]int, len(blocks))
+	widths := make([]int, len(blocks[0]))
+	for i, blockRow := range blocks {
+		if len(blockRow) != len(widths) {
+			return nil, fmt.Errorf("block row %d has %d blocks, expected %d as block row 0", i, len(blockRow), len(widths))
+		}
+		for j, block := range blockRow {
+			if block == nil {
+				return nil, fmt.Errorf("block (%d, %d) is nil", i, j)
+			}
+			if j == 0 {
+				heights[i] = block.nbRows
+			}
+			if i == 0 {
+				widths[j] = block.nbCols
+			}
+			if block.nbRows != heights[i] {
+				return nil, fmt.Errorf("block (%d, %d) has %d rows, expected %d as the other blocks of block row %d", i, j, block.nbRows, heights[i], i)
+			}
+			if block.nbCols != widths[j] {
+				return nil, fmt.Errorf("block (%d, %d) has %d columns, expected %d as the other blocks of block column %d", i, j, block.nbCols, widths[j], j)
+			}
+		}
+	}
+
+	result := NewOf[T](sumSizes(heights), sumSizes(widths))
+	row := 0
+	for i, blockRow := range blocks {
+		col := 0
+		for j, block := range blockRow {
+			for k := 0; k < block.nbRows; k++ {
+				copy(result.row(row + k)[col:col+widths[j]], block.row(k))
+			}
+			col += widths[j]
+		}
+		row += heights[i]
+	}
+
+	return result, nil
+}
+
+// Returns the matrix [A1 | A2 | ...] made of the given matrices side by side.
+//
+// Returns an error if the matrices do not all have the same number of rows.
+// No matrix gives an empty (0x0) matrix.
+func HStack[T vector.Float](matrices ...*MatrixOf[T]) (*MatrixOf[T], error) {
+	result, err := Block([][]*MatrixOf[T]{matrices})
+	if err != nil {
+		return nil, fmt.Errorf("cannot stack matrices horizontally: %w", err)
+	}
+
+	return result, nil
+}
+
+// Returns the matrix made of the given matrices stacked on top of each other,
+// the first one at the top.
+//
+// Returns an error if the matrices do not all have the same number of columns.
+// No matrix gives an empty (0x0) matrix.
+func VStack[T vector.Float](matrices ...*MatrixOf[T]) (*MatrixOf[T], error) {
+	blocks := make([][]*MatrixOf[T], len(matrices))
+	for i, m := range matrices {
+		blocks[i] = []*MatrixOf[T]{m}
+	}
+
+	result, err := Block(blocks)
+	if err != nil {
+		return nil, fmt.Errorf("cannot stack matrices vertically: %w", err)
+	}
+
+	return result, nil
+}
+
+// Returns the augmented matrix [A | B], where A is the calling matrix,
+// for instance to run elimination on a system along with its right-hand sides.
+//
+// Returns an error if the matrices do not have the same number of rows.
+func (m *MatrixOf[T]) Augment(other *MatrixOf[T]) (*MatrixOf[T], error) {
+	return HStack(m, other)
+}
+
+// Splits the matrix into blocks, the inverse of Block: block (i, j) has
+// rowSizes[i] rows and colSizes[j] columns.
+//
+// The blocks are views sharing the storage of the calling matrix (see Slice),
+// use Copy on them to get independent matrices.
+// Returns an error if a size is negative, or if the sizes do not add up
+// to the number of rows or columns of the matrix.
+func (m *MatrixOf[T]) SplitBlocks(rowSizes, colSizes []int) ([][]*MatrixOf[T], error) {
+	if err := checkBlockSizes(rowSizes, m.nbRows, "row"); err != nil {
+		return nil, err
+	}
+	if err := checkBlockSizes(colSizes, m.nbCols, "column"); err != nil {
+		return nil, err
+	}
+
+	blocks := make([][]*MatrixOf[T], len(rowSizes))
+	row := 0
+	for i, height := range rowSizes {
+		blocks[i] = make([]*MatrixOf[T], len(colSizes))
+		col := 0
+		for j, width := range colSizes {
+			// Cannot fail, the sizes were checked
+			blocks[i][j], _ = m.Slice(row, row+height, col, col+width)
+			col += width
+		}
+		row += height
+	}
+
+	return blocks, nil
+}
+
+// Checks that the block sizes are non-negative and add up to total.
+//
+// The dimension ("row" or "column") is used to build the error message.
+func checkBlockSizes(sizes []int, total int, dimension string) error {
+	for i, size := range sizes {
+		if size < 0 {
+			return fmt.Errorf("negative %s size %d for block %d, cannot split matrix", dimension, size, i)
+		}
+	}
+	if sumSizes(sizes) != total {
+		return fmt.Errorf("%s sizes of blocks add up to %d instead of %d, cannot split matrix", dimension, sumSizes(sizes), total)
+	}
+
+	return nil
+}
+
+// Returns the sum of the given block sizes.
+func sumSizes(values []int) int {
+	total := 0
+	for _, val := range values {
+		total += val
+	}
+
+	return total
+}
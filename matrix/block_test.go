@@ -0,0 +1,179 @@
+package matrix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKron(t *testing.T) {
+	a, _ := NewFromData([][]float64{
+		{1, 2},
+		{3, 4},
+	})
+	b, _ := NewFromData([][]float64{
+		{0, 5},
+		{6, 7},
+	})
+	expected, _ := NewFromData([][]float64{
+		{0, 5, 0, 10},
+		{6, 7, 12, 14},
+		{0, 15, 0, 20},
+		{18, 21, 24, 28},
+	})
+
+	assert.True(t, Kron(a, b).EqualsApprox(expected, 0))
+}
+
+func TestKron_Shapes(t *testing.T) {
+	result := Kron(newSequenceMatrix(2, 3), newSequenceMatrix(1, 2))
+
+	assert.Equal(t, 2, result.GetNbRows())
+	assert.Equal(t, 6, result.GetNbCols())
+	assert.True(t, Kron(NewIdentity(2), NewIdentity(3)).IsIdentity())
+	assert.Equal(t, 0, Kron(New(0, 0), NewIdentity(3)).GetNbRows())
+}
+
+func TestKron_MixedProduct(t *testing.T) {
+	// (A ⊗ B)(C ⊗ D) = AC ⊗ BD
+	a, c := newSequenceMatrix(2, 3), newSequenceMatrix(3, 2)
+	b, d := NewFromFlat(2, 2, []float64{1, -2, -3, 4}), NewIdentity(2).MulScalar(2)
+
+	left, err := Kron(a, b).Mul(Kron(c, d))
+	require.NoError(t, err)
+	ac, _ := a.Mul(c)
+	bd, _ := b.Mul(d)
+
+	assert.True(t, left.EqualsApprox(Kron(ac, bd), 1e-12))
+}
+
+func TestBlock(t *testing.T) {
+	a := NewIdentity(2)
+	b := newSequenceMatrix(2, 1)
+	c := newSequenceMatrix(1, 2)
+	d := NewFromFlat(1, 1, []float64{9})
+	expected, _ := NewFromData([][]float64{
+		{1, 0, 1},
+		{0, 1, 2},
+		{1, 2, 9},
+	})
+
+	result, err := Block([][]*Matrix{{a, b}, {c, d}})
+	require.NoError(t, err)
+
+	assert.True(t, result.EqualsApprox(expected, 0))
+	// The elements are copied
+	a.SetElementAt(0, 0, 5)
+	assert.Equal(t, 1.0, result.GetElementAt(0, 0))
+}
+
+func TestBlock_ShouldFail(t *testing.T) {
+	a := NewIdentity(2)
+
+	_, errRows := Block([][]*Matrix{{a, New(3, 1)}})
+	_, errCols := Block([][]*Matrix{{a}, {New(1, 3)}})
+	_, errRagged := Block([][]*Matrix{{a, a}, {a}})
+	_, errNil := Block([][]*Matrix{{a, nil}})
+	_, errEmptyRow := Block([][]*Matrix{{}, {a}})
+
+	assert.ErrorContains(t, errRows, "block (0, 1) has 3 rows")
+	assert.ErrorContains(t, errCols, "block (1, 0) has 3 columns")
+	assert.ErrorContains(t, errRagged, "block row 1 has 1 blocks")
+	assert.ErrorContains(t, errNil, "block (0, 1) is nil")
+	assert.Error(t, errEmptyRow)
+}
+
+func TestBlock_Empty(t *testing.T) {
+	empty, err := Block([][]*Matrix{})
+	require.NoError(t, err)
+	emptyRows, err := Block([][]*Matrix{{}, {}})
+	require.NoError(t, err)
+	withEmptyBlock, err := Block([][]*Matrix{{NewIdentity(2), New(2, 0)}})
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, empty.GetNbRows())
+	assert.Equal(t, 0, emptyRows.GetNbCols())
+	assert.True(t, withEmptyBlock.IsIdentity())
+}
+
+func TestHStackAndVStack(t *testing.T) {
+	a := newSequenceMatrix(2, 2)
+	b := newSequenceMatrix(2, 1)
+
+	h, err := HStack(a, b, a)
+	require.NoError(t, err)
+	v, err := VStack(a, a.Transpose())
+	require.NoError(t, err)
+
+	assert.Equal(t, [][]float64{{1, 2, 1, 1, 2}, {3, 4, 2, 3, 4}}, h.GetData())
+	assert.Equal(t, [][]float64{{1, 2}, {3, 4}, {1, 3}, {2, 4}}, v.GetData())
+
+	_, err = HStack(a, newSequenceMatrix(3, 1))
+	assert.ErrorContains(t, err, "horizontally")
+	_, err = VStack(a, b)
+	assert.ErrorContains(t, err, "vertically")
+
+	noMatrix, err := HStack[float64]()
+	require.NoError(t, err)
+	assert.Equal(t, 0, noMatrix.GetNbRows())
+}
+
+func TestAugment(t *testing.T) {
+	m := newSequenceMatrix(2, 2)
+	b := NewFromFlat(2, 1, []float64{5, 6})
+
+	augmented, err := m.Augment(b)
+	require.NoError(t, err)
+
+	assert.Equal(t, [][]float64{{1, 2, 5}, {3, 4, 6}}, augmented.GetData())
+	_, err = m.Augment(New(1, 1))
+	assert.Error(t, err)
+}
+
+func TestSplitBlocks(t *testing.T) {
+	m := newSequenceMatrix(3, 4)
+
+	blocks, err := m.SplitBlocks([]int{1, 2}, []int{3, 0, 1})
+	require.NoError(t, err)
+
+	require.Len(t, blocks, 2)
+	require.Len(t, blocks[0], 3)
+	assert.Equal(t, [][]float64{{1, 2, 3}}, blocks[0][0].GetData())
+	assert.Equal(t, [][]float64{{8}, {12}}, blocks[1][2].GetData())
+	assert.Equal(t, 0, blocks[1][1].GetNbCols())
+
+	// Split then Block gives the matrix back
+	rebuilt, err := Block(blocks)
+	require.NoError(t, err)
+	assert.True(t, rebuilt.EqualsApprox(m, 0))
+
+	// Blocks are views
+	blocks[1][0].SetElementAt(0, 0, 50)
+	assert.Equal(t, 50.0, m.GetElementAt(1, 0))
+}
+
+func TestSplitBlocks_ZeroSize(t *testing.T) {
+	m := newSequenceMatrix(3, 3)
+
+	blocks, err := m.SplitBlocks([]int{3}, []int{0, 3})
+	require.NoError(t, err)
+	empty := blocks[0][0].Copy()
+	rest := blocks[0][1].Copy()
+
+	assert.Equal(t, 3, empty.GetNbRows())
+	assert.Equal(t, 0, empty.GetNbCols())
+	assert.True(t, rest.EqualsApprox(m, 0))
+}
+
+func TestSplitBlocks_ShouldFail(t *testing.T) {
+	m := newSequenceMatrix(3, 4)
+
+	_, errRows := m.SplitBlocks([]int{1, 1}, []int{4})
+	_, errCols := m.SplitBlocks([]int{3}, []int{2, 3})
+	_, errNegative := m.SplitBlocks([]int{4, -1}, []int{4})
+
+	assert.ErrorContains(t, errRows, "row sizes")
+	assert.ErrorContains(t, errCols, "column sizes")
+	assert.ErrorContains(t, errNegative, "negative row size")
+}
@@ -0,0 +1,286 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/JoLandry/linalgo/vector"
+)
+
+// Returned when a factorization requires a positive (semi-)definite matrix
+// and the given one is not.
+//
+// It is wrapped with some context, use errors.Is to check for it.
+var ErrNotPositiveDefinite = errors.New("matrix is not positive definite")
+
+// Cholesky holds the Cholesky factorization of a symmetric positive definite matrix.
+//
+// The factorization satisfies A = L * L^T, where L is lower triangular with
+// a positive diagonal. It costs about half the work of the LU factorization
+// and needs no pivoting.
+type Cholesky struct {
+	l *Matrix
+}
+
+// LDL holds the LDL^T factorization with symmetric pivoting of a symmetric
+// positive semi-definite matrix.
+//
+// The factorization satisfies P * A * P^T = L * D * L^T, where P is a permutation
+// matrix, L is unit lower triangular and D is diagonal with non-negative values.
+// The diagonal of D is non-increasing, and only its first Rank() values are non-zero.
+type LDL struct {
+	l *Matrix
+	d []float64
+	// perm[i] is the row (and column) of A that ended up at position i
+	perm []int
+	rank int
+}
+
+// Computes and returns the Cholesky factorization of the matrix.
+//
+// Returns an error if the matrix is not square or not symmetric, or an error
+// wrapping ErrNotPositiveDefinite if it is not positive definite.
+// The factorization is computed in float64. The original matrix is not modified.
+func (m *MatrixOf[T]) Cholesky() (*Cholesky, error) {
+	if err := m.checkSymmetric("Cholesky factorization"); err != nil {
+		return nil, err
+	}
+
+	return choleskyLower(float64Of(m))
+}
+
+// Computes the Cholesky factorization of a square matrix, reading only its
+// lower triangle.
+//
+// Returns an error wrapping ErrNotPositiveDefinite if a non-positive pivot is met.
+func choleskyLower(m *Matrix) (*Cholesky, error) {
+	n := m.nbRows
+	l := New(n, n)
+	for j := 0; j < n; j++ {
+		sum := m.row(j)[j]
+		for k := 0; k < j; k++ {
+			sum -= l.row(j)[k] * l.row(j)[k]
+		}
+		if sum <= 0.0 {
+			return nil, fmt.Errorf("%w: non-positive pivot at row %d", ErrNotPositiveDefinite, j)
+		}
+		l.row(j)[j] = math.Sqrt(sum)
+
+		for i := j + 1; i < n; i++ {
+			sum := m.row(i)[j]
+			for k := 0; k < j; k++ {
+				sum -= l.row(i)[k] * l.row(j)[k]
+			}
+			l.row(i)[j] = sum / l.row(j)[j]
+		}
+	}
+
+	return &Cholesky{l: l}, nil
+}
+
+// Tells whether the matrix is symmetric positive definite,
+// namely whether its Cholesky factorization exists.
+func (m *MatrixOf[T]) IsPositiveDefinite() bool {
+	_, err := m.Cholesky()
+	return err == nil
+}
+
+// Tells whether the matrix is symmetric positive semi-definite,
+// namely whether its pivoted LDL^T factorization exists.
+func (m *MatrixOf[T]) IsPositiveSemiDefinite() bool {
+	_, err := m.LDLPivoted()
+	return err == nil
+}
+
+// Returns the lower triangular factor L.
+func (c *Cholesky) L() *Matrix {
+	return c.l.Copy()
+}
+
+// Returns the determinant of the factorized matrix, namely the
+// square of the product of the diagonal of L.
+//
+// By convention, the determinant of an empty (0x0) matrix is zero.
+func (c *Cholesky) Determinant() float64 {
+	n := c.l.nbRows
+	if n == 0 {
+		return 0.0
+	}
+	determinant := 1.0
+	for i := 0; i < n; i++ {
+		determinant *= c.l.row(i)[i]
+	}
+
+	return determinant * determinant
+}
+
+// Solves A * x = b, where A is the factorized matrix.
+//
+// Returns an error if b does not have as many elements as A has rows.
+func (c *Cholesky) Solve(b *vector.Vector) (*vector.Vector, error) {
+	n := c.l.nbRows
+	if b.GetSize() != n {
+		return nil, fmt.Errorf("mismatch between size of factorized matrix (%d) and size of right-hand side (%d)", n, b.GetSize())
+	}
+
+	x, err := c.SolveMatrix(NewFromFlat(n, 1, b.GetData()))
+	if err != nil {
+		return nil, err
+	}
+
+	return x.colToVector(0), nil
+}
+
+// Solves A * X = B, where A is the factorized matrix and each column
+// of B is a right-hand side.
+//
+// Returns an error if B does not have as many rows as A.
+func (c *Cholesky) SolveMatrix(b *Matrix) (*Matrix, error) {
+	n := c.l.nbRows
+	if b.nbRows != n {
+		return nil, fmt.Errorf("mismatch between size of factorized matrix (%d) and number of rows of right-hand side (%d)", n, b.nbRows)
+	}
+
+	x := New(n, b.nbCols)
+	for i := 0; i < n; i++ {
+		copy(x.row(i), b.row(i))
+	}
+	for col := 0; col < b.nbCols; col++ {
+		// Forward substitution with L
+		for i := 0; i < n; i++ {
+			sum := x.row(i)[col]
+			for k := 0; k < i; k++ {
+				sum -= c.l.row(i)[k] * x.row(k)[col]
+			}
+			x.row(i)[col] = sum / c.l.row(i)[i]
+		}
+		// Back substitution with L^T
+		for i := n - 1; i >= 0; i-- {
+			sum := x.row(i)[col]
+			for k := i + 1; k < n; k++ {
+				sum -= c.l.row(k)[i] * x.row(k)[col]
+			}
+			x.row(i)[col] = sum / c.l.row(i)[i]
+		}
+	}
+
+	return x, nil
+}
+
+// Returns the inverse of the factorized matrix.
+func (c *Cholesky) Inverse() *Matrix {
+	inv, err := c.SolveMatrix(NewIdentity(c.l.nbRows))
+	if err != nil {
+		panic(fmt.Sprintf("invalid factorization: %v", err))
+	}
+
+	return inv
+}
+
+// Computes and returns the LDL^T factorization with symmetric pivoting of the matrix.
+//
+// At each step, the largest remaining diagonal element is chosen as the pivot.
+// The factorization stops once all remaining diagonal elements are negligible,
+// which reveals the rank of positive semi-definite matrices.
+//
+// Returns an error if the matrix is not square or not symmetric, or an error
+// wrapping ErrNotPositiveDefinite if it is not positive semi-definite.
+// The factorization is computed in float64, and negligible diagonal elements
+// are detected relative to the precision of T. The original matrix is not modified.
+func (m *MatrixOf[T]) LDLPivoted() (*LDL, error) {
+	if err := m.checkSymmetric("LDL^T factorization"); err != nil {
+		return nil, err
+	}
+
+	n := m.nbRows
+	a := float64Of(m).Copy()
+	l := NewIdentity(n)
+	d := make([]float64, n)
+	perm := make([]int, n)
+	for i := range perm {
+		perm[i] = i
+	}
+
+	maxDiag := 0.0
+	for i := 0; i < n; i++ {
+		maxDiag = math.Max(maxDiag, math.Abs(a.row(i)[i]))
+	}
+	tolerance := float64(n) * vector.Epsilon[T]() * maxDiag
+
+	rank := 0
+	for k := 0; k < n; k++ {
+		// Find the largest remaining diagonal element
+		p := k
+		for i := k + 1; i < n; i++ {
+			if a.row(i)[i] > a.row(p)[p] {
+				p = i
+			}
+		}
+		if a.row(p)[p] <= tolerance {
+			break
+		}
+
+		// Symmetric permutation of rows and columns k and p
+		if p != k {
+			a.swapRows(k, p)
+			for i := 0; i < n; i++ {
+				a.row(i)[k], a.row(i)[p] = a.row(i)[p], a.row(i)[k]
+			}
+			for j := 0; j < k; j++ {
+				l.row(k)[j], l.row(p)[j] = l.row(p)[j], l.row(k)[j]
+			}
+			perm[k], perm[p] = perm[p], perm[k]
+		}
+
+		// Outer product update of the trailing submatrix
+		d[k] = a.row(k)[k]
+		for i := k + 1; i < n; i++ {
+			l.row(i)[k] = a.row(i)[k] / d[k]
+		}
+		for i := k + 1; i < n; i++ {
+			for j := k + 1; j <= i; j++ {
+				a.row(i)[j] -= l.row(i)[k] * l.row(j)[k] * d[k]
+				a.row(j)[i] = a.row(i)[j]
+			}
+		}
+		rank++
+	}
+
+	// The remaining trailing submatrix must be (numerically) zero
+	for i := rank; i < n; i++ {
+		for j := rank; j < n; j++ {
+			if math.Abs(a.row(i)[j]) > tolerance {
+				return nil, fmt.Errorf("%w: matrix is not positive semi-definite", ErrNotPositiveDefinite)
+			}
+		}
+	}
+
+	return &LDL{l: l, d: d, perm: perm, rank: rank}, nil
+}
+
+// Returns the unit lower triangular factor L.
+func (f *LDL) L() *Matrix {
+	return f.l.Copy()
+}
+
+// Returns the diagonal factor D.
+func (f *LDL) D() *Matrix {
+	return NewDiagonal(f.d)
+}
+
+// Returns the permutation matrix P such that P * A * P^T = L * D * L^T.
+func (f *LDL) P() *Matrix {
+	n := len(f.perm)
+	p := New(n, n)
+	for i, row := range f.perm {
+		p.row(i)[row] = 1.0
+	}
+
+	return p
+}
+
+// Returns the rank of the factorized matrix, namely the number of non-zero values of D.
+func (f *LDL) Rank() int {
+	return f.rank
+}
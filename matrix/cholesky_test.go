@@ -0,0 +1,131 @@
+package matrix
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/JoLandry/linalgo/vector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCholesky_FactorReconstructsMatrix(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{4, 12, -16},
+		{12, 37, -43},
+		{-16, -43, 98},
+	})
+
+	chol, err := m.Cholesky()
+	require.NoError(t, err)
+
+	expected, _ := NewFromData([][]float64{
+		{2, 0, 0},
+		{6, 1, 0},
+		{-8, 5, 3},
+	})
+	assert.True(t, chol.L().EqualsApprox(expected, 1e-12), "expected %v, got %v", expected, chol.L())
+	assert.InDelta(t, 36.0, chol.Determinant(), 1e-9)
+}
+
+func TestCholesky_ShouldFail_NotPositiveDefinite(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{1, 2},
+		{2, 1},
+	})
+
+	_, err := m.Cholesky()
+
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, ErrNotPositiveDefinite))
+}
+
+func TestCholesky_ShouldFail_NotSymmetric(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{4, 1},
+		{0, 4},
+	})
+
+	_, err := m.Cholesky()
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not symmetric")
+}
+
+func TestCholesky_Solve(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{4, 1, 0},
+		{1, 3, 1},
+		{0, 1, 2},
+	})
+	b := vector.NewFromData([]float64{1, 2, 3})
+
+	chol, err := m.Cholesky()
+	require.NoError(t, err)
+	x, err := chol.Solve(b)
+	require.NoError(t, err)
+
+	product, _ := m.MulVec(x)
+	assert.InDeltaSlice(t, b.GetData(), product.GetData(), 1e-12)
+
+	inverse, err := m.Invert()
+	require.NoError(t, err)
+	assert.True(t, chol.Inverse().EqualsApprox(inverse, 1e-12))
+
+	_, err = chol.Solve(vector.New(2))
+	assert.Error(t, err)
+}
+
+func TestIsPositiveDefinite(t *testing.T) {
+	spd, _ := NewFromData([][]float64{
+		{2, -1},
+		{-1, 2},
+	})
+	semiDefinite, _ := NewFromData([][]float64{
+		{1, 1},
+		{1, 1},
+	})
+
+	assert.True(t, spd.IsPositiveDefinite())
+	assert.False(t, semiDefinite.IsPositiveDefinite())
+	assert.True(t, semiDefinite.IsPositiveSemiDefinite())
+	assert.False(t, New(2, 3).IsPositiveDefinite())
+}
+
+func TestLDLPivoted_SemiDefiniteMatrix(t *testing.T) {
+	// Gram matrix of two vectors of R^3, hence of rank 2
+	g, _ := NewFromData([][]float64{
+		{1, 2},
+		{0, 1},
+		{3, -1},
+	})
+	m, _ := g.Mul(g.Transpose())
+
+	ldl, err := m.LDLPivoted()
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, ldl.Rank())
+	pa, _ := ldl.P().Mul(m)
+	pap, _ := pa.Mul(ldl.P().Transpose())
+	ld, _ := ldl.L().Mul(ldl.D())
+	ldlt, _ := ld.Mul(ldl.L().Transpose())
+	assert.True(t, pap.EqualsApprox(ldlt, 1e-9), "expected %v, got %v", pap, ldlt)
+
+	d := ldl.D()
+	for i := 1; i < 3; i++ {
+		assert.LessOrEqual(t, d.GetElementAt(i, i), d.GetElementAt(i-1, i-1))
+	}
+}
+
+func TestLDLPivoted_ShouldFail_Indefinite(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{0, 1},
+		{1, 0},
+	})
+
+	_, err := m.LDLPivoted()
+
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, ErrNotPositiveDefinite))
+	assert.False(t, m.IsPositiveSemiDefinite())
+}
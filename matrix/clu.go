@@ -0,0 +1,223 @@
+package matrix
+
+import (
+	"fmt"
+	"math/cmplx"
+
+	"github.com/JoLandry/linalgo/vector"
+)
+
+// CLU holds the LU factorization with partial pivoting of a square complex matrix.
+//
+// The factorization satisfies P * A = L * U, where P is a permutation matrix,
+// L is unit lower triangular and U is upper triangular.
+type CLU struct {
+	// L (strictly below the diagonal, unit diagonal implied) and U packed together
+	lu *CMatrix
+	// pivot[i] is the row of A that ended up at row i
+	pivot []int
+	// Sign of the permutation (+1 or -1)
+	sign complex128
+}
+
+// Computes and returns the LU factorization with partial pivoting of the complex matrix.
+//
+// At each step, the row holding the element of largest modulus in the current
+// column is chosen as the pivot row. Singular matrices are factorized as well,
+// use IsSingular on the result to check them.
+//
+// Returns an error if the matrix is not square.
+// The original matrix is not modified.
+func (m *CMatrix) LU() (*CLU, error) {
+	if !m.IsSquare() {
+		return nil, fmt.Errorf("cannot compute the LU factorization of a non-square matrix")
+	}
+
+	n := m.nbRows
+	lu := m.Copy()
+	pivot := make([]int, n)
+	for i := range pivot {
+		pivot[i] = i
+	}
+	var sign complex128 = 1
+
+	for k := 0; k < n; k++ {
+		// Find pivot element (max modulus in column k at or below row k)
+		p := k
+		maxVal := cmplx.Abs(lu.row(k)[k])
+		for r := k + 1; r < n; r++ {
+			if cmplx.Abs(lu.row(r)[k]) > maxVal {
+				maxVal = cmplx.Abs(lu.row(r)[k])
+				p = r
+			}
+		}
+		if p != k {
+			lu.swapRows(k, p)
+			pivot[k], pivot[p] = pivot[p], pivot[k]
+			sign = -sign
+		}
+		// Nothing to eliminate in this column
+		if lu.row(k)[k] == 0 {
+			continue
+		}
+		// Eliminate below, storing the multipliers in place
+		pivotRow := lu.row(k)
+		for r := k + 1; r < n; r++ {
+			values := lu.row(r)
+			factor := values[k] / pivotRow[k]
+			values[k] = factor
+			if factor == 0 {
+				continue
+			}
+			for c := k + 1; c < n; c++ {
+				values[c] -= factor * pivotRow[c]
+			}
+		}
+	}
+
+	return &CLU{lu: lu, pivot: pivot, sign: sign}, nil
+}
+
+// Returns the unit lower triangular factor L.
+func (f *CLU) L() *CMatrix {
+	n := f.lu.nbRows
+	l := NewCMatrix(n, n)
+	for i := 0; i < n; i++ {
+		copy(l.row(i)[:i], f.lu.row(i)[:i])
+		l.row(i)[i] = 1
+	}
+
+	return l
+}
+
+// Returns the upper triangular factor U.
+func (f *CLU) U() *CMatrix {
+	n := f.lu.nbRows
+	u := NewCMatrix(n, n)
+	for i := 0; i < n; i++ {
+		copy(u.row(i)[i:], f.lu.row(i)[i:])
+	}
+
+	return u
+}
+
+// Returns the row permutation of the factorization.
+//
+// Element i is the index of the row of the original matrix that
+// was moved to row i. The returned slice is a copy.
+func (f *CLU) Pivot() []int {
+	pivot := make([]int, len(f.pivot))
+	copy(pivot, f.pivot)
+
+	return pivot
+}
+
+// Tells whether the factorized matrix is singular, namely
+// whether the modulus of one of the pivots is (numerically) zero.
+//
+// By convention, an empty (0x0) matrix is considered singular.
+func (f *CLU) IsSingular() bool {
+	n := f.lu.nbRows
+	if n == 0 {
+		return true
+	}
+	for i := 0; i < n; i++ {
+		if cmplx.Abs(f.lu.row(i)[i]) < pivotTolerance {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns the determinant of the factorized matrix, computed as the
+// product of the diagonal of U times the sign of the permutation.
+//
+// By convention, the determinant of an empty (0x0) matrix is zero.
+func (f *CLU) Determinant() complex128 {
+	n := f.lu.nbRows
+	if n == 0 {
+		return 0
+	}
+	determinant := f.sign
+	for i := 0; i < n; i++ {
+		determinant *= f.lu.row(i)[i]
+	}
+
+	return determinant
+}
+
+// Solves A * x = b with the LU factorization, where A is the factorized matrix.
+//
+// Returns an error if b does not have as many elements as A has rows,
+// or an error wrapping ErrSingular if A is singular.
+func (f *CLU) Solve(b *vector.CVector) (*vector.CVector, error) {
+	n := f.lu.nbRows
+	if b.GetSize() != n {
+		return nil, fmt.Errorf("mismatch between size of factorized matrix (%d) and size of right-hand side (%d)", n, b.GetSize())
+	}
+
+	x, err := f.SolveMatrix(&CMatrix{data: b.GetData(), nbRows: n, nbCols: 1})
+	if err != nil {
+		return nil, err
+	}
+
+	return vector.NewCVectorFromData(x.data), nil
+}
+
+// Solves A * X = B for X, where A is the factorized matrix and each
+// column of B is a right-hand side.
+//
+// Returns an error if B does not have as many rows as A,
+// or an error wrapping ErrSingular if A is singular.
+func (f *CLU) SolveMatrix(b *CMatrix) (*CMatrix, error) {
+	n := f.lu.nbRows
+	if b.nbRows != n {
+		return nil, fmt.Errorf("mismatch between size of factorized matrix (%d) and number of rows of right-hand side (%d)", n, b.nbRows)
+	}
+	if f.IsSingular() {
+		return nil, fmt.Errorf("%w, cannot solve", ErrSingular)
+	}
+
+	// Apply the permutation
+	x := NewCMatrix(n, b.nbCols)
+	for i, row := range f.pivot {
+		copy(x.row(i), b.row(row))
+	}
+	// Forward substitution with L (unit diagonal), on all columns at once
+	for i := 0; i < n; i++ {
+		xi := x.row(i)
+		for k, factor := range f.lu.row(i)[:i] {
+			for j, val := range x.row(k) {
+				xi[j] -= factor * val
+			}
+		}
+	}
+	// Back substitution with U
+	for i := n - 1; i >= 0; i-- {
+		xi := x.row(i)
+		luRow := f.lu.row(i)
+		for k := i + 1; k < n; k++ {
+			for j, val := range x.row(k) {
+				xi[j] -= luRow[k] * val
+			}
+		}
+		for j := range xi {
+			xi[j] /= luRow[i]
+		}
+	}
+
+	return x, nil
+}
+
+// Returns the inverse of the factorized matrix.
+//
+// Returns an error wrapping ErrSingular if the matrix is singular.
+func (f *CLU) Inverse() (*CMatrix, error) {
+	inv, err := f.SolveMatrix(NewCIdentity(f.lu.nbRows))
+	if err != nil {
+		return nil, fmt.Errorf("%w, cannot invert", ErrSingular)
+	}
+
+	return inv, nil
+}
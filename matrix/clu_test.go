@@ -0,0 +1,98 @@
+package matrix
+
+import (
+	"errors"
+	"math/cmplx"
+	"testing"
+
+	"github.com/JoLandry/linalgo/vector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCLU_ShouldFail_NonSquareMatrix(t *testing.T) {
+	lu, err := NewCMatrix(2, 3).LU()
+
+	assert.Nil(t, lu)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "non-square")
+}
+
+func TestCLU_FactorsReconstructPermutedMatrix(t *testing.T) {
+	m, _ := NewCMatrixFromData([][]complex128{
+		{2, 1 - 1i, 0},
+		{1i, 3, 2 + 1i},
+		{1, -1i, 4},
+	})
+
+	lu, err := m.LU()
+	require.NoError(t, err)
+
+	product, err := lu.L().Mul(lu.U())
+	require.NoError(t, err)
+	for i, row := range lu.Pivot() {
+		for j := 0; j < 3; j++ {
+			assert.InDelta(t, 0.0, cmplx.Abs(product.GetElementAt(i, j)-m.GetElementAt(row, j)), 1e-12)
+		}
+	}
+}
+
+func TestCLU_Determinant_MatchesRealLU(t *testing.T) {
+	realMatrix, _ := NewFromData([][]float64{
+		{0, 2, 1},
+		{1, 1, 1},
+		{2, 1, 3},
+	})
+
+	lu, err := NewCMatrixFromReal(realMatrix).LU()
+	require.NoError(t, err)
+
+	assert.InDelta(t, -3.0, real(lu.Determinant()), 1e-12)
+	assert.InDelta(t, 0.0, imag(lu.Determinant()), 1e-12)
+}
+
+func TestCLU_IsSingular(t *testing.T) {
+	// Second row is i times the first one
+	singular, _ := NewCMatrixFromData([][]complex128{{1, 2i}, {1i, -2}})
+
+	lu, err := singular.LU()
+	require.NoError(t, err)
+	empty, err := NewCMatrix(0, 0).LU()
+	require.NoError(t, err)
+
+	assert.True(t, lu.IsSingular())
+	assert.True(t, empty.IsSingular())
+	assert.Equal(t, complex128(0), empty.Determinant())
+
+	_, err = lu.Solve(vector.NewCVector(2))
+	assert.True(t, errors.Is(err, ErrSingular))
+	_, err = lu.Inverse()
+	assert.True(t, errors.Is(err, ErrSingular))
+}
+
+func TestCLU_SolveMatrix_MultipleRightHandSides(t *testing.T) {
+	m, _ := NewCMatrixFromData([][]complex128{
+		{2, 1 - 1i, 0},
+		{1i, 3, 2 + 1i},
+		{1, -1i, 4},
+	})
+	b, _ := NewCMatrixFromData([][]complex128{{1, 1i}, {0, 2}, {1 - 1i, 3}})
+
+	lu, err := m.LU()
+	require.NoError(t, err)
+	x, err := lu.SolveMatrix(b)
+	require.NoError(t, err)
+
+	product, _ := m.Mul(x)
+	assert.True(t, product.EqualsApprox(b, 1e-12))
+}
+
+func TestCLU_Solve_ShouldFail_DimensionMismatch(t *testing.T) {
+	lu, err := NewCIdentity(2).LU()
+	require.NoError(t, err)
+
+	_, err = lu.Solve(vector.NewCVector(3))
+	assert.Error(t, err)
+	_, err = lu.SolveMatrix(NewCMatrix(3, 1))
+	assert.Error(t, err)
+}
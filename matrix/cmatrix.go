@@ -0,0 +1,405 @@
+package matrix
+
+import (
+	"fmt"
+	"math/cmplx"
+	"strings"
+
+	"github.com/JoLandry/linalgo/vector"
+)
+
+// CMatrix represents a two-dimensional matrix of complex128 values.
+//
+// Elements are stored contiguously in row-major order: element (i, j) is
+// located at index i*nbCols + j of data.
+type CMatrix struct {
+	data   []complex128
+	nbRows int
+	nbCols int
+}
+
+// String returns a human-readable string representation of the complex matrix.
+func (m *CMatrix) String() string {
+	if m.nbRows == 0 || m.nbCols == 0 {
+		return "[]"
+	}
+
+	var builder strings.Builder
+	builder.WriteString("[\n")
+	for i := 0; i < m.nbRows; i++ {
+		builder.WriteString("  [")
+		for j := 0; j < m.nbCols; j++ {
+			builder.WriteString(fmt.Sprintf("%.4f", m.row(i)[j]))
+			if j < m.nbCols-1 {
+				builder.WriteString(", ")
+			}
+		}
+		builder.WriteString("]")
+		if i < m.nbRows-1 {
+			builder.WriteString(",\n")
+		}
+	}
+	builder.WriteString("\n]")
+
+	return builder.String()
+}
+
+// Get the data of the complex matrix, as a 2D slice (deep copy)
+func (m *CMatrix) GetData() [][]complex128 {
+	data := make([][]complex128, m.nbRows)
+	for i := range data {
+		data[i] = make([]complex128, m.nbCols)
+		copy(data[i], m.row(i))
+	}
+	return data
+}
+
+// Get the numbers of Rows of the complex matrix
+func (m *CMatrix) GetNbRows() int {
+	return m.nbRows
+}
+
+// Get the number of columns of the complex matrix
+func (m *CMatrix) GetNbCols() int {
+	return m.nbCols
+}
+
+// Returns true if the complex matrix is a square matrix, false otherwise
+func (m *CMatrix) IsSquare() bool {
+	return m.nbCols == m.nbRows
+}
+
+// Get the element at indices (row,col)
+//
+// It panics if the indices are out of range.
+func (m *CMatrix) GetElementAt(row int, col int) complex128 {
+	m.checkIndices(row, col)
+	return m.data[row*m.nbCols+col]
+}
+
+// Set the element called elt at indices (row,col)
+//
+// It panics if the indices are out of range.
+func (m *CMatrix) SetElementAt(row int, col int, elt complex128) {
+	m.checkIndices(row, col)
+	m.data[row*m.nbCols+col] = elt
+}
+
+// Panics if (row, col) is not a position of the matrix, which the flat
+// storage would otherwise map to another element.
+func (m *CMatrix) checkIndices(row int, col int) {
+	if row < 0 || row >= m.nbRows || col < 0 || col >= m.nbCols {
+		panic(fmt.Sprintf("index (%d, %d) out of range for a %dx%d complex matrix", row, col, m.nbRows, m.nbCols))
+	}
+}
+
+// Returns the elements of row i, sharing the storage of the matrix.
+func (m *CMatrix) row(i int) []complex128 {
+	start := i * m.nbCols
+	return m.data[start : start+m.nbCols : start+m.nbCols]
+}
+
+// Swaps rows i and j of the complex matrix in place.
+func (m *CMatrix) swapRows(i int, j int) {
+	if i == j {
+		return
+	}
+	rowI := m.row(i)
+	rowJ := m.row(j)
+	for k := range rowI {
+		rowI[k], rowJ[k] = rowJ[k], rowI[k]
+	}
+}
+
+// Returns a deep copy of the complex matrix.
+func (m *CMatrix) Copy() *CMatrix {
+	result := NewCMatrix(m.nbRows, m.nbCols)
+	copy(result.data, m.data)
+	return result
+}
+
+// Create a new complex matrix from a given number of rows and a given number of columns
+func NewCMatrix(nbRowsMat int, nbColsMat int) *CMatrix {
+	return &CMatrix{
+		data:   make([]complex128, nbRowsMat*nbColsMat),
+		nbRows: nbRowsMat,
+		nbCols: nbColsMat,
+	}
+}
+
+// Create a new complex matrix from a given 2D slice (deep copy)
+//
+// If the input has no row, then a pointer to a new empty matrix
+// Is returned
+//
+// Return an error if there is any inconsistency in the number of columns
+func NewCMatrixFromData(mData [][]complex128) (*CMatrix, error) {
+	nbRows := len(mData)
+	if nbRows == 0 {
+		return NewCMatrix(0, 0), nil
+	}
+
+	nbCols := len(mData[0])
+	result := NewCMatrix(nbRows, nbCols)
+	for i := range mData {
+		if len(mData[i]) != nbCols {
+			return nil, fmt.Errorf("inconsistent number of columns in row %d: expected %d, got %d", i, nbCols, len(mData[i]))
+		}
+		copy(result.row(i), mData[i])
+	}
+
+	return result, nil
+}
+
+// Creates and returns a complex matrix with the elements of a real matrix as real parts.
+func NewCMatrixFromReal(m *Matrix) *CMatrix {
+	result := NewCMatrix(m.nbRows, m.nbCols)
+	for i := 0; i < m.nbRows; i++ {
+		for j, val := range m.row(i) {
+			result.row(i)[j] = complex(val, 0)
+		}
+	}
+
+	return result
+}
+
+// Creates and returns a new complex identity matrix of a given size.
+func NewCIdentity(size int) *CMatrix {
+	result := NewCMatrix(size, size)
+	for k := 0; k < size; k++ {
+		result.data[k*size+k] = 1
+	}
+
+	return result
+}
+
+// Returns the real parts of the elements of the complex matrix, as a real matrix.
+func (m *CMatrix) Real() *Matrix {
+	result := New(m.nbRows, m.nbCols)
+	for i, val := range m.data {
+		result.data[i] = real(val)
+	}
+
+	return result
+}
+
+// Returns the imaginary parts of the elements of the complex matrix, as a real matrix.
+func (m *CMatrix) Imag() *Matrix {
+	result := New(m.nbRows, m.nbCols)
+	for i, val := range m.data {
+		result.data[i] = imag(val)
+	}
+
+	return result
+}
+
+// Returns a new complex matrix that is the element-wise sum of m and other.
+//
+// Returns an error if the matrices do not have the same dimensions.
+func (m *CMatrix) Add(other *CMatrix) (*CMatrix, error) {
+	if m.nbRows != other.nbRows || m.nbCols != other.nbCols {
+		return nil, fmt.Errorf("cannot add matrices of different dimensions: %dx%d vs %dx%d", m.nbRows, m.nbCols, other.nbRows, other.nbCols)
+	}
+
+	result := NewCMatrix(m.nbRows, m.nbCols)
+	for i := range m.data {
+		result.data[i] = m.data[i] + other.data[i]
+	}
+
+	return result, nil
+}
+
+// Returns a new complex matrix that is the element-wise difference of m and other.
+//
+// Returns an error if the matrices do not have the same dimensions.
+func (m *CMatrix) Sub(other *CMatrix) (*CMatrix, error) {
+	if m.nbRows != other.nbRows || m.nbCols != other.nbCols {
+		return nil, fmt.Errorf("cannot subtract matrices of different dimensions: %dx%d vs %dx%d", m.nbRows, m.nbCols, other.nbRows, other.nbCols)
+	}
+
+	result := NewCMatrix(m.nbRows, m.nbCols)
+	for i := range m.data {
+		result.data[i] = m.data[i] - other.data[i]
+	}
+
+	return result, nil
+}
+
+// Returns a new complex matrix where each element of m is multiplied by the given scalar.
+func (m *CMatrix) MulScalar(scalar complex128) *CMatrix {
+	result := m.Copy()
+	for i := range result.data {
+		result.data[i] *= scalar
+	}
+
+	return result
+}
+
+// Returns the product of the complex matrix m and the complex matrix other.
+//
+// Returns an error if the number of columns of m does not match
+// the number of rows of other.
+func (m *CMatrix) Mul(other *CMatrix) (*CMatrix, error) {
+	if m.nbCols != other.nbRows {
+		return nil, fmt.Errorf("mismatch between number of columns of first matrix (%d) and number of rows of second matrix (%d), cannot perform multiplication", m.nbCols, other.nbRows)
+	}
+
+	result := NewCMatrix(m.nbRows, other.nbCols)
+	for i := 0; i < m.nbRows; i++ {
+		resultRow := result.row(i)
+		for k, val := range m.row(i) {
+			if val == 0 {
+				continue
+			}
+			for j, otherVal := range other.row(k) {
+				resultRow[j] += val * otherVal
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// Performs the product between the complex matrix and a complex vector.
+//
+// Returns an error if the number of columns of the matrix does not match the size of the vector.
+func (m *CMatrix) MulVec(v *vector.CVector) (*vector.CVector, error) {
+	if m.nbCols != v.GetSize() {
+		return nil, fmt.Errorf("mismatch between number of columns of matrix (%d) and size of vector (%d), cannot perform multiplication", m.nbCols, v.GetSize())
+	}
+
+	values := v.GetData()
+	result := vector.NewCVector(m.nbRows)
+	for i := 0; i < m.nbRows; i++ {
+		var sum complex128
+		for j, val := range m.row(i) {
+			sum += val * values[j]
+		}
+		result.SetElementAt(i, sum)
+	}
+
+	return result, nil
+}
+
+// Returns the transpose of the complex matrix, without conjugating its elements.
+func (m *CMatrix) Transpose() *CMatrix {
+	result := NewCMatrix(m.nbCols, m.nbRows)
+	for i := 0; i < m.nbRows; i++ {
+		for j, val := range m.row(i) {
+			result.row(j)[i] = val
+		}
+	}
+
+	return result
+}
+
+// Returns the complex conjugate of the matrix, element by element.
+func (m *CMatrix) Conj() *CMatrix {
+	result := NewCMatrix(m.nbRows, m.nbCols)
+	for i, val := range m.data {
+		result.data[i] = cmplx.Conj(val)
+	}
+
+	return result
+}
+
+// Returns the conjugate transpose (Hermitian adjoint) A^H of the complex matrix.
+func (m *CMatrix) ConjTranspose() *CMatrix {
+	result := NewCMatrix(m.nbCols, m.nbRows)
+	for i := 0; i < m.nbRows; i++ {
+		for j, val := range m.row(i) {
+			result.row(j)[i] = cmplx.Conj(val)
+		}
+	}
+
+	return result
+}
+
+// Compares two complex matrices for approximate equality.
+//
+// It returns true if both matrices have the same dimensions and the modulus
+// of the difference of each pair of corresponding elements is no more than epsilon.
+func (m *CMatrix) EqualsApprox(other *CMatrix, epsilon float64) bool {
+	if m.nbRows != other.nbRows || m.nbCols != other.nbCols {
+		return false
+	}
+	for i := range m.data {
+		if cmplx.Abs(m.data[i]-other.data[i]) > epsilon {
+			return false
+		}
+	}
+	return true
+}
+
+// Tells whether the complex matrix is Hermitian, namely whether each element
+// differs from the conjugate of its transposed element by no more than epsilon.
+//
+// The diagonal of a Hermitian matrix is real.
+// By convention, an empty (0x0) matrix is considered Hermitian.
+func (m *CMatrix) IsHermitian(epsilon float64) bool {
+	if !m.IsSquare() {
+		return false
+	}
+
+	for i := 0; i < m.nbRows; i++ {
+		for j := i; j < m.nbCols; j++ {
+			if cmplx.Abs(m.row(i)[j]-cmplx.Conj(m.row(j)[i])) > epsilon {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Tells whether the complex matrix is unitary, namely whether A^H * A
+// is the identity matrix, up to epsilon on each element.
+//
+// By convention, an empty (0x0) matrix is considered unitary.
+func (m *CMatrix) IsUnitary(epsilon float64) bool {
+	if !m.IsSquare() {
+		return false
+	}
+
+	product, _ := m.ConjTranspose().Mul(m)
+	return product.EqualsApprox(NewCIdentity(m.nbRows), epsilon)
+}
+
+// Returns the determinant of the complex matrix.
+//
+// It returns an error if the matrix is not square.
+// Internally, it uses the LU factorization with partial pivoting.
+// By convention, the determinant of an empty (0x0) matrix is zero.
+func (m *CMatrix) Determinant() (complex128, error) {
+	lu, err := m.LU()
+	if err != nil {
+		return 0, fmt.Errorf("cannot compute the determinant of a non-square matrix")
+	}
+	return lu.Determinant(), nil
+}
+
+// Returns the inverse of the complex matrix.
+//
+// Returns an error if the matrix is not square, or an error wrapping
+// ErrSingular if it is singular. The original matrix is not modified.
+func (m *CMatrix) Invert() (*CMatrix, error) {
+	lu, err := m.LU()
+	if err != nil {
+		return nil, fmt.Errorf("matrix is not squared, it cannot be inverted")
+	}
+
+	return lu.Inverse()
+}
+
+// Solves the square linear system A * x = b, where A is the calling complex matrix.
+//
+// Returns an error if A is not square or if b does not have as many elements
+// as A has rows, or an error wrapping ErrSingular if A is singular.
+func (m *CMatrix) Solve(b *vector.CVector) (*vector.CVector, error) {
+	lu, err := m.LU()
+	if err != nil {
+		return nil, err
+	}
+
+	return lu.Solve(b)
+}
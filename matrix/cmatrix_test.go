@@ -0,0 +1,193 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"github.com/JoLandry/linalgo/vector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCMatrixFromData(t *testing.T) {
+	m, _ := NewCMatrixFromData([][]complex128{
+		{2, 1 - 1i, 0},
+		{1i, 3, 2 + 1i},
+		{1, -1i, 4},
+	})
+
+	assert.Equal(t, 3, m.GetNbRows())
+	assert.Equal(t, 3, m.GetNbCols())
+	assert.True(t, m.IsSquare())
+	assert.Equal(t, 2+1i, m.GetElementAt(1, 2))
+	m.SetElementAt(1, 2, 5)
+	assert.Equal(t, complex128(5), m.GetData()[1][2])
+}
+
+func TestCMatrix_ElementAt_ShouldPanic_OutOfRange(t *testing.T) {
+	m := NewCMatrix(2, 2)
+
+	assert.Panics(t, func() { m.SetElementAt(0, 2, 5) })
+	assert.Panics(t, func() { m.GetElementAt(2, 0) })
+	assert.Panics(t, func() { m.GetElementAt(0, -1) })
+	assert.Equal(t, complex128(0), m.GetElementAt(1, 0))
+}
+
+func TestNewCMatrixFromData_ShouldFail_Inconsistent(t *testing.T) {
+	_, err := NewCMatrixFromData([][]complex128{{1, 2}, {3}})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "inconsistent")
+}
+
+func TestNewCMatrixFromReal_RealImag(t *testing.T) {
+	realMatrix, _ := NewFromData([][]float64{{1, 2}, {3, 4}})
+
+	m := NewCMatrixFromReal(realMatrix)
+
+	assert.True(t, m.Real().EqualsApprox(realMatrix, 0))
+	assert.True(t, m.Imag().IsZero())
+
+	complexMatrix, _ := NewCMatrixFromData([][]complex128{
+		{2, 1 - 1i, 0},
+		{1i, 3, 2 + 1i},
+		{1, -1i, 4},
+	})
+	assert.True(t, complexMatrix.Imag().EqualsApprox(NewFromFlat(3, 3, []float64{0, -1, 0, 1, 0, 1, 0, -1, 0}), 0))
+}
+
+func TestCMatrix_String(t *testing.T) {
+	m, _ := NewCMatrixFromData([][]complex128{{1i}})
+
+	assert.Equal(t, "[]", NewCMatrix(0, 0).String())
+	assert.Equal(t, "[\n  [(0.0000+1.0000i)]\n]", m.String())
+}
+
+func TestCMatrix_AddSubMulScalar(t *testing.T) {
+	m, _ := NewCMatrixFromData([][]complex128{
+		{2, 1 - 1i, 0},
+		{1i, 3, 2 + 1i},
+		{1, -1i, 4},
+	})
+
+	sum, err := m.Add(m)
+	require.NoError(t, err)
+	diff, err := m.Sub(m)
+	require.NoError(t, err)
+
+	assert.True(t, sum.EqualsApprox(m.MulScalar(2), 0))
+	assert.True(t, diff.EqualsApprox(NewCMatrix(3, 3), 0))
+
+	_, err = m.Add(NewCMatrix(2, 2))
+	assert.Error(t, err)
+	_, err = m.Sub(NewCMatrix(2, 2))
+	assert.Error(t, err)
+}
+
+func TestCMatrix_Mul(t *testing.T) {
+	a, _ := NewCMatrixFromData([][]complex128{{1, 1i}, {0, 2}})
+	b, _ := NewCMatrixFromData([][]complex128{{1i, 0}, {1, 1 - 1i}})
+	expected, _ := NewCMatrixFromData([][]complex128{{2i, 1 + 1i}, {2, 2 - 2i}})
+
+	product, err := a.Mul(b)
+	require.NoError(t, err)
+
+	assert.True(t, product.EqualsApprox(expected, 1e-12))
+	_, err = a.Mul(NewCMatrix(3, 1))
+	assert.Error(t, err)
+}
+
+func TestCMatrix_MulVec(t *testing.T) {
+	m, _ := NewCMatrixFromData([][]complex128{{1, 1i}, {1i, 1}})
+	v := vector.NewCVectorFromData([]complex128{1, 1i})
+
+	result, err := m.MulVec(v)
+	require.NoError(t, err)
+
+	assert.Equal(t, []complex128{0, 2i}, result.GetData())
+	_, err = m.MulVec(vector.NewCVector(3))
+	assert.Error(t, err)
+}
+
+func TestCMatrix_ConjTranspose(t *testing.T) {
+	m, _ := NewCMatrixFromData([][]complex128{{1 + 2i, 3}, {4i, 5 - 1i}, {6, 7}})
+
+	h := m.ConjTranspose()
+
+	assert.Equal(t, 2, h.GetNbRows())
+	assert.Equal(t, 3, h.GetNbCols())
+	assert.Equal(t, 1-2i, h.GetElementAt(0, 0))
+	assert.Equal(t, -4i, h.GetElementAt(0, 1))
+	assert.True(t, h.EqualsApprox(m.Transpose().Conj(), 0))
+	assert.True(t, h.ConjTranspose().EqualsApprox(m, 0))
+}
+
+func TestCMatrix_IsHermitian(t *testing.T) {
+	hermitian, _ := NewCMatrixFromData([][]complex128{{2, 1 - 1i}, {1 + 1i, 3}})
+	complexDiagonal, _ := NewCMatrixFromData([][]complex128{{1i, 0}, {0, 1}})
+	symmetric, _ := NewCMatrixFromData([][]complex128{{2, 1i}, {1i, 3}})
+
+	assert.True(t, hermitian.IsHermitian(1e-12))
+	assert.False(t, complexDiagonal.IsHermitian(1e-12))
+	assert.False(t, symmetric.IsHermitian(1e-12))
+	assert.False(t, NewCMatrix(2, 3).IsHermitian(1e-12))
+	assert.True(t, NewCMatrix(0, 0).IsHermitian(1e-12))
+}
+
+func TestCMatrix_IsUnitary(t *testing.T) {
+	s := complex(1/math.Sqrt2, 0)
+	hadamard, _ := NewCMatrixFromData([][]complex128{{s, s}, {s, -s}})
+	phase, _ := NewCMatrixFromData([][]complex128{{1, 0}, {0, 1i}})
+	notUnitary, _ := NewCMatrixFromData([][]complex128{{1, 1i}, {0, 1}})
+
+	assert.True(t, hadamard.IsUnitary(1e-12))
+	assert.True(t, phase.IsUnitary(1e-12))
+	assert.False(t, notUnitary.IsUnitary(1e-12))
+	assert.False(t, NewCMatrix(2, 3).IsUnitary(1e-12))
+}
+
+func TestCMatrix_Determinant(t *testing.T) {
+	m, _ := NewCMatrixFromData([][]complex128{{1, 1i}, {2i, 3}})
+
+	det, err := m.Determinant()
+	require.NoError(t, err)
+
+	// 1*3 - i*2i
+	assert.InDelta(t, 5.0, real(det), 1e-12)
+	assert.InDelta(t, 0.0, imag(det), 1e-12)
+
+	_, err = NewCMatrix(2, 3).Determinant()
+	assert.Error(t, err)
+}
+
+func TestCMatrix_Invert(t *testing.T) {
+	m, _ := NewCMatrixFromData([][]complex128{
+		{2, 1 - 1i, 0},
+		{1i, 3, 2 + 1i},
+		{1, -1i, 4},
+	})
+
+	inv, err := m.Invert()
+	require.NoError(t, err)
+
+	product, _ := m.Mul(inv)
+	assert.True(t, product.EqualsApprox(NewCIdentity(3), 1e-12))
+
+	_, err = NewCMatrix(2, 3).Invert()
+	assert.Error(t, err)
+}
+
+func TestCMatrix_Solve(t *testing.T) {
+	m, _ := NewCMatrixFromData([][]complex128{
+		{2, 1 - 1i, 0},
+		{1i, 3, 2 + 1i},
+		{1, -1i, 4},
+	})
+	expected := vector.NewCVectorFromData([]complex128{1, -1i, 2 + 1i})
+	b, _ := m.MulVec(expected)
+
+	x, err := m.Solve(b)
+	require.NoError(t, err)
+
+	assert.True(t, x.EqualsApprox(expected, 1e-12))
+}
@@ -0,0 +1,626 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+
+	"github.com/JoLandry/linalgo/vector"
+)
+
+// Maximum number of QR iterations per eigenvalue before giving up.
+const maxQRIterations = 100
+
+// Eigen holds the eigen-decomposition of a real square matrix.
+//
+// Eigenvalues are complex in general. Those of a real matrix come in conjugate
+// pairs, which are stored next to each other, the one with positive imaginary
+// part first. Column i of the eigenvector matrix is a unit eigenvector for the
+// i-th eigenvalue.
+type Eigen struct {
+	values  []complex128
+	vectors [][]complex128
+}
+
+// Computes and returns the eigenvalues and eigenvectors of the matrix.
+//
+// The matrix is first reduced to upper Hessenberg form with Householder
+// similarity transformations, then to real Schur form with shifted (Francis
+// double shift) QR iterations. Eigenvectors are obtained by back substitution
+// in the Schur form. This is the algorithm of the EISPACK routines orthes and hqr2.
+//
+// Returns an error if the matrix is not square, or if the QR iterations do not converge.
+// The decomposition is computed in float64, and the original matrix is not modified.
+// Use EigenSym for symmetric matrices, which is more accurate and returns real results.
+func (m *MatrixOf[T]) Eigen() (*Eigen, error) {
+	if !m.IsSquare() {
+		return nil, fmt.Errorf("cannot compute the eigen-decomposition of a non-square matrix")
+	}
+
+	n := m.nbRows
+	h := float64Of(m).Copy()
+	if n == 0 {
+		return &Eigen{values: []complex128{}, vectors: [][]complex128{}}, nil
+	}
+
+	v := reduceToHessenberg(h)
+	d, e, err := hessenbergToSchur(h, v)
+	if err != nil {
+		return nil, err
+	}
+
+	// Assemble complex eigenvalues and unit eigenvectors
+	values := make([]complex128, n)
+	vectors := make([][]complex128, n)
+	for i := range vectors {
+		vectors[i] = make([]complex128, n)
+	}
+	for j := 0; j < n; j++ {
+		values[j] = complex(d[j], e[j])
+		for i := 0; i < n; i++ {
+			switch {
+			case e[j] == 0.0:
+				vectors[i][j] = complex(v.row(i)[j], 0)
+			case e[j] > 0.0:
+				vectors[i][j] = complex(v.row(i)[j], v.row(i)[j+1])
+			default:
+				vectors[i][j] = complex(v.row(i)[j-1], -v.row(i)[j])
+			}
+		}
+		norm := 0.0
+		for i := 0; i < n; i++ {
+			norm = math.Hypot(norm, cmplx.Abs(vectors[i][j]))
+		}
+		if norm != 0.0 {
+			for i := 0; i < n; i++ {
+				vectors[i][j] /= complex(norm, 0)
+			}
+		}
+	}
+
+	return &Eigen{values: values, vectors: vectors}, nil
+}
+
+// Returns the eigenvalues.
+//
+// The returned slice is a copy.
+func (e *Eigen) Values() []complex128 {
+	values := make([]complex128, len(e.values))
+	copy(values, e.values)
+
+	return values
+}
+
+// Returns the eigenvectors as the columns of a n x n complex array,
+// in the same order as the eigenvalues.
+//
+// The returned array is a copy.
+func (e *Eigen) Vectors() [][]complex128 {
+	vectors := make([][]complex128, len(e.vectors))
+	for i := range e.vectors {
+		vectors[i] = make([]complex128, len(e.vectors[i]))
+		copy(vectors[i], e.vectors[i])
+	}
+
+	return vectors
+}
+
+// Tells whether all the eigenvalues are real.
+func (e *Eigen) IsReal() bool {
+	for _, val := range e.values {
+		if imag(val) != 0.0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Returns the matrices P and D such that A = P * D * P^-1, where D is the
+// diagonal matrix of the eigenvalues and the columns of P are the matching
+// unit eigenvectors.
+//
+// Returns an error if the matrix is not square, if it has complex eigenvalues
+// (it is then not diagonalizable over the reals), or if it is defective,
+// namely if its eigenvectors do not form a basis: the matrix of eigenvectors P
+// is then so ill-conditioned that cond_1(P) >= 1 / (n * eps), eps being the
+// machine epsilon of T.
+func (m *MatrixOf[T]) Diagonalize() (*MatrixOf[T], *MatrixOf[T], error) {
+	eig, err := m.Eigen()
+	if err != nil {
+		return nil, nil, err
+	}
+	if !eig.IsReal() {
+		return nil, nil, fmt.Errorf("matrix has complex eigenvalues, it is not diagonalizable over the reals")
+	}
+
+	n := m.nbRows
+	p := New(n, n)
+	diag := make([]float64, n)
+	for j := 0; j < n; j++ {
+		diag[j] = real(eig.values[j])
+		for i := 0; i < n; i++ {
+			p.row(i)[j] = real(eig.vectors[i][j])
+		}
+	}
+	// The eigenvectors of a defective matrix come out numerically parallel, so
+	// P is flagged from its conditioning rather than from an absolute threshold
+	lu, _ := p.LU()
+	if lu.Cond1Estimate()*float64(n)*vector.Epsilon[T]() >= 1.0 {
+		return nil, nil, fmt.Errorf("matrix is defective, it is not diagonalizable")
+	}
+
+	return fromFloat64[T](p), fromFloat64[T](NewDiagonal(diag)), nil
+}
+
+// Reduces h in place to upper Hessenberg form with Householder similarity
+// transformations, and returns the accumulated orthogonal transformation.
+//
+// This helper assumes h is square and not empty.
+func reduceToHessenberg(h *Matrix) *Matrix {
+	n := h.nbRows
+	high := n - 1
+	ort := make([]float64, n)
+
+	for m := 1; m <= high-1; m++ {
+		// Scale column
+		scale := 0.0
+		for i := m; i <= high; i++ {
+			scale += math.Abs(h.row(i)[m-1])
+		}
+		if scale == 0.0 {
+			continue
+		}
+
+		// Compute Householder transformation
+		sum := 0.0
+		for i := high; i >= m; i-- {
+			ort[i] = h.row(i)[m-1] / scale
+			sum += ort[i] * ort[i]
+		}
+		g := math.Sqrt(sum)
+		if ort[m] > 0 {
+			g = -g
+		}
+		sum -= ort[m] * g
+		ort[m] -= g
+
+		// Apply Householder similarity transformation H = (I - u*u'/h) * H * (I - u*u'/h)
+		for j := m; j < n; j++ {
+			f := 0.0
+			for i := high; i >= m; i-- {
+				f += ort[i] * h.row(i)[j]
+			}
+			f /= sum
+			for i := m; i <= high; i++ {
+				h.row(i)[j] -= f * ort[i]
+			}
+		}
+		for i := 0; i <= high; i++ {
+			f := 0.0
+			for j := high; j >= m; j-- {
+				f += ort[j] * h.row(i)[j]
+			}
+			f /= sum
+			for j := m; j <= high; j++ {
+				h.row(i)[j] -= f * ort[j]
+			}
+		}
+		ort[m] *= scale
+		h.row(m)[m-1] = scale * g
+	}
+
+	// Accumulate transformations
+	v := NewIdentity(n)
+	for m := high - 1; m >= 1; m-- {
+		if h.row(m)[m-1] == 0.0 {
+			continue
+		}
+		for i := m + 1; i <= high; i++ {
+			ort[i] = h.row(i)[m-1]
+		}
+		for j := m; j <= high; j++ {
+			g := 0.0
+			for i := m; i <= high; i++ {
+				g += ort[i] * v.row(i)[j]
+			}
+			// Double division avoids possible underflow
+			g = (g / ort[m]) / h.row(m)[m-1]
+			for i := m; i <= high; i++ {
+				v.row(i)[j] += g * ort[i]
+			}
+		}
+	}
+
+	return v
+}
+
+// Reduces the upper Hessenberg matrix h in place to real Schur form with shifted
+// QR iterations, accumulating the transformations in v, then overwrites v with
+// the eigenvectors.
+//
+// It returns the real and imaginary parts of the eigenvalues. Eigenvectors of
+// a complex pair (j, j+1) are stored as v[:, j] + i * v[:, j+1].
+// This helper assumes h is upper Hessenberg, square and not empty.
+func hessenbergToSchur(h *Matrix, v *Matrix) ([]float64, []float64, error) {
+	nn := h.nbRows
+	n := nn - 1
+	d := make([]float64, nn)
+	e := make([]float64, nn)
+	exshift := 0.0
+	var p, q, r, s, z, t, w, x, y float64
+
+	norm := 0.0
+	for i := 0; i < nn; i++ {
+		for j := max(i-1, 0); j < nn; j++ {
+			norm += math.Abs(h.row(i)[j])
+		}
+	}
+
+	// Outer loop over eigenvalue index
+	iter := 0
+	for n >= 0 {
+		// Look for single small sub-diagonal element
+		l := n
+		for l > 0 {
+			s = math.Abs(h.row(l - 1)[l-1]) + math.Abs(h.row(l)[l])
+			if s == 0.0 {
+				s = norm
+			}
+			if math.Abs(h.row(l)[l-1]) < machineEpsilon*s {
+				break
+			}
+			l--
+		}
+
+		if l == n {
+			// One root found
+			h.row(n)[n] += exshift
+			d[n] = h.row(n)[n]
+			e[n] = 0.0
+			n--
+			iter = 0
+		} else if l == n-1 {
+			// Two roots found
+			w = h.row(n)[n-1] * h.row(n - 1)[n]
+			p = (h.row(n - 1)[n-1] - h.row(n)[n]) / 2.0
+			q = p*p + w
+			z = math.Sqrt(math.Abs(q))
+			h.row(n)[n] += exshift
+			h.row(n - 1)[n-1] += exshift
+			x = h.row(n)[n]
+
+			if q >= 0 {
+				// Real pair
+				if p >= 0 {
+					z = p + z
+				} else {
+					z = p - z
+				}
+				d[n-1] = x + z
+				d[n] = d[n-1]
+				if z != 0.0 {
+					d[n] = x - w/z
+				}
+				e[n-1] = 0.0
+				e[n] = 0.0
+				x = h.row(n)[n-1]
+				s = math.Abs(x) + math.Abs(z)
+				p = x / s
+				q = z / s
+				r = math.Sqrt(p*p + q*q)
+				p /= r
+				q /= r
+
+				// Row modification
+				for j := n - 1; j < nn; j++ {
+					z = h.row(n - 1)[j]
+					h.row(n - 1)[j] = q*z + p*h.row(n)[j]
+					h.row(n)[j] = q*h.row(n)[j] - p*z
+				}
+				// Column modification
+				for i := 0; i <= n; i++ {
+					z = h.row(i)[n-1]
+					h.row(i)[n-1] = q*z + p*h.row(i)[n]
+					h.row(i)[n] = q*h.row(i)[n] - p*z
+				}
+				// Accumulate transformations
+				for i := 0; i < nn; i++ {
+					z = v.row(i)[n-1]
+					v.row(i)[n-1] = q*z + p*v.row(i)[n]
+					v.row(i)[n] = q*v.row(i)[n] - p*z
+				}
+			} else {
+				// Complex pair
+				d[n-1] = x + p
+				d[n] = x + p
+				e[n-1] = z
+				e[n] = -z
+			}
+			n -= 2
+			iter = 0
+		} else {
+			// No convergence yet
+			if iter >= maxQRIterations {
+				return nil, nil, fmt.Errorf("QR iterations did not converge, cannot compute the eigen-decomposition")
+			}
+
+			// Form shift
+			x = h.row(n)[n]
+			y = 0.0
+			w = 0.0
+			if l < n {
+				y = h.row(n - 1)[n-1]
+				w = h.row(n)[n-1] * h.row(n - 1)[n]
+			}
+
+			// Wilkinson's original ad hoc shift
+			if iter == 10 {
+				exshift += x
+				for i := 0; i <= n; i++ {
+					h.row(i)[i] -= x
+				}
+				s = math.Abs(h.row(n)[n-1]) + math.Abs(h.row(n - 1)[n-2])
+				x = 0.75 * s
+				y = x
+				w = -0.4375 * s * s
+			}
+
+			// MATLAB's ad hoc shift
+			if iter == 30 {
+				s = (y - x) / 2.0
+				s = s*s + w
+				if s > 0 {
+					s = math.Sqrt(s)
+					if y < x {
+						s = -s
+					}
+					s = x - w/((y-x)/2.0+s)
+					for i := 0; i <= n; i++ {
+						h.row(i)[i] -= s
+					}
+					exshift += s
+					x = 0.964
+					y = x
+					w = x
+				}
+			}
+
+			iter++
+
+			// Look for two consecutive small sub-diagonal elements
+			m := n - 2
+			for m >= l {
+				z = h.row(m)[m]
+				r = x - z
+				s = y - z
+				p = (r*s-w)/h.row(m + 1)[m] + h.row(m)[m+1]
+				q = h.row(m + 1)[m+1] - z - r - s
+				r = h.row(m + 2)[m+1]
+				s = math.Abs(p) + math.Abs(q) + math.Abs(r)
+				p /= s
+				q /= s
+				r /= s
+				if m == l {
+					break
+				}
+				if math.Abs(h.row(m)[m-1])*(math.Abs(q)+math.Abs(r)) <
+					machineEpsilon*(math.Abs(p)*(math.Abs(h.row(m - 1)[m-1])+math.Abs(z)+math.Abs(h.row(m + 1)[m+1]))) {
+					break
+				}
+				m--
+			}
+
+			for i := m + 2; i <= n; i++ {
+				h.row(i)[i-2] = 0.0
+				if i > m+2 {
+					h.row(i)[i-3] = 0.0
+				}
+			}
+
+			// Double QR step involving rows l:n and columns m:n
+			for k := m; k <= n-1; k++ {
+				notLast := k != n-1
+				if k != m {
+					p = h.row(k)[k-1]
+					q = h.row(k + 1)[k-1]
+					r = 0.0
+					if notLast {
+						r = h.row(k + 2)[k-1]
+					}
+					x = math.Abs(p) + math.Abs(q) + math.Abs(r)
+					if x == 0.0 {
+						continue
+					}
+					p /= x
+					q /= x
+					r /= x
+				}
+
+				s = math.Sqrt(p*p + q*q + r*r)
+				if p < 0 {
+					s = -s
+				}
+				if s == 0 {
+					continue
+				}
+				if k != m {
+					h.row(k)[k-1] = -s * x
+				} else if l != m {
+					h.row(k)[k-1] = -h.row(k)[k-1]
+				}
+				p += s
+				x = p / s
+				y = q / s
+				z = r / s
+				q /= p
+				r /= p
+
+				// Row modification
+				for j := k; j < nn; j++ {
+					p = h.row(k)[j] + q*h.row(k + 1)[j]
+					if notLast {
+						p += r * h.row(k + 2)[j]
+						h.row(k + 2)[j] -= p * z
+					}
+					h.row(k)[j] -= p * x
+					h.row(k + 1)[j] -= p * y
+				}
+				// Column modification
+				for i := 0; i <= min(n, k+3); i++ {
+					p = x*h.row(i)[k] + y*h.row(i)[k+1]
+					if notLast {
+						p += z * h.row(i)[k+2]
+						h.row(i)[k+2] -= p * r
+					}
+					h.row(i)[k] -= p
+					h.row(i)[k+1] -= p * q
+				}
+				// Accumulate transformations
+				for i := 0; i < nn; i++ {
+					p = x*v.row(i)[k] + y*v.row(i)[k+1]
+					if notLast {
+						p += z * v.row(i)[k+2]
+						v.row(i)[k+2] -= p * r
+					}
+					v.row(i)[k] -= p
+					v.row(i)[k+1] -= p * q
+				}
+			}
+		}
+	}
+
+	// Back substitute to find vectors of upper triangular form
+	if norm == 0.0 {
+		return d, e, nil
+	}
+	for n = nn - 1; n >= 0; n-- {
+		p = d[n]
+		q = e[n]
+
+		if q == 0 {
+			// Real vector
+			l := n
+			h.row(n)[n] = 1.0
+			for i := n - 1; i >= 0; i-- {
+				w = h.row(i)[i] - p
+				r = 0.0
+				for j := l; j <= n; j++ {
+					r += h.row(i)[j] * h.row(j)[n]
+				}
+				if e[i] < 0.0 {
+					z = w
+					s = r
+					continue
+				}
+				l = i
+				if e[i] == 0.0 {
+					if w != 0.0 {
+						h.row(i)[n] = -r / w
+					} else {
+						h.row(i)[n] = -r / (machineEpsilon * norm)
+					}
+				} else {
+					// Solve real equations
+					x = h.row(i)[i+1]
+					y = h.row(i + 1)[i]
+					q = (d[i]-p)*(d[i]-p) + e[i]*e[i]
+					t = (x*s - z*r) / q
+					h.row(i)[n] = t
+					if math.Abs(x) > math.Abs(z) {
+						h.row(i + 1)[n] = (-r - w*t) / x
+					} else {
+						h.row(i + 1)[n] = (-s - y*t) / z
+					}
+				}
+
+				// Overflow control
+				t = math.Abs(h.row(i)[n])
+				if (machineEpsilon*t)*t > 1 {
+					for j := i; j <= n; j++ {
+						h.row(j)[n] /= t
+					}
+				}
+			}
+		} else if q < 0 {
+			// Complex vector
+			l := n - 1
+
+			// Last vector component imaginary so matrix is triangular
+			if math.Abs(h.row(n)[n-1]) > math.Abs(h.row(n - 1)[n]) {
+				h.row(n - 1)[n-1] = q / h.row(n)[n-1]
+				h.row(n - 1)[n] = -(h.row(n)[n] - p) / h.row(n)[n-1]
+			} else {
+				c := complex(0.0, -h.row(n - 1)[n]) / complex(h.row(n - 1)[n-1]-p, q)
+				h.row(n - 1)[n-1] = real(c)
+				h.row(n - 1)[n] = imag(c)
+			}
+			h.row(n)[n-1] = 0.0
+			h.row(n)[n] = 1.0
+			for i := n - 2; i >= 0; i-- {
+				ra := 0.0
+				sa := 0.0
+				for j := l; j <= n; j++ {
+					ra += h.row(i)[j] * h.row(j)[n-1]
+					sa += h.row(i)[j] * h.row(j)[n]
+				}
+				w = h.row(i)[i] - p
+
+				if e[i] < 0.0 {
+					z = w
+					r = ra
+					s = sa
+					continue
+				}
+				l = i
+				if e[i] == 0 {
+					c := complex(-ra, -sa) / complex(w, q)
+					h.row(i)[n-1] = real(c)
+					h.row(i)[n] = imag(c)
+				} else {
+					// Solve complex equations
+					x = h.row(i)[i+1]
+					y = h.row(i + 1)[i]
+					vr := (d[i]-p)*(d[i]-p) + e[i]*e[i] - q*q
+					vi := (d[i] - p) * 2.0 * q
+					if vr == 0.0 && vi == 0.0 {
+						vr = machineEpsilon * norm * (math.Abs(w) + math.Abs(q) + math.Abs(x) + math.Abs(y) + math.Abs(z))
+					}
+					c := complex(x*r-z*ra+q*sa, x*s-z*sa-q*ra) / complex(vr, vi)
+					h.row(i)[n-1] = real(c)
+					h.row(i)[n] = imag(c)
+					if math.Abs(x) > (math.Abs(z) + math.Abs(q)) {
+						h.row(i + 1)[n-1] = (-ra - w*h.row(i)[n-1] + q*h.row(i)[n]) / x
+						h.row(i + 1)[n] = (-sa - w*h.row(i)[n] - q*h.row(i)[n-1]) / x
+					} else {
+						c = complex(-r-y*h.row(i)[n-1], -s-y*h.row(i)[n]) / complex(z, q)
+						h.row(i + 1)[n-1] = real(c)
+						h.row(i + 1)[n] = imag(c)
+					}
+				}
+
+				// Overflow control
+				t = math.Max(math.Abs(h.row(i)[n-1]), math.Abs(h.row(i)[n]))
+				if (machineEpsilon*t)*t > 1 {
+					for j := i; j <= n; j++ {
+						h.row(j)[n-1] /= t
+						h.row(j)[n] /= t
+					}
+				}
+			}
+		}
+	}
+
+	// Back transformation to get eigenvectors of original matrix
+	for j := nn - 1; j >= 0; j-- {
+		for i := 0; i < nn; i++ {
+			z = 0.0
+			for k := 0; k <= j; k++ {
+				z += v.row(i)[k] * h.row(k)[j]
+			}
+			v.row(i)[j] = z
+		}
+	}
+
+	return d, e, nil
+}
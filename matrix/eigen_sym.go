@@ -0,0 +1,190 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/JoLandry/linalgo/vector"
+)
+
+// Relative tolerance used to decide whether a matrix of float64 elements is symmetric.
+// It is scaled with vector.Tolerance for other element types.
+const symmetryTolerance = 1e-10
+
+// EigenSym holds the eigen-decomposition of a real symmetric matrix.
+//
+// The decomposition satisfies A = V * D * V^T, where V is orthogonal and D is
+// diagonal. Eigenvalues are real and sorted in non-decreasing order, and
+// column i of V is a unit eigenvector for the i-th eigenvalue.
+type EigenSym struct {
+	values  []float64
+	vectors *Matrix
+}
+
+// Tells whether the matrix is symmetric, namely whether each element
+// differs from its transposed element by no more than epsilon.
+//
+// By convention, an empty (0x0) matrix is considered symmetric.
+func (m *MatrixOf[T]) IsSymmetric(epsilon float64) bool {
+	if !m.IsSquare() {
+		return false
+	}
+
+	for i := 0; i < m.nbRows; i++ {
+		for j := i + 1; j < m.nbCols; j++ {
+			if math.Abs(float64(m.row(i)[j]-m.row(j)[i])) > epsilon {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Returns an error if the matrix is not square or not symmetric, with a
+// tolerance relative to its largest element and to the precision of T.
+//
+// The name of the operation is used in the error messages.
+func (m *MatrixOf[T]) checkSymmetric(operation string) error {
+	if !m.IsSquare() {
+		return fmt.Errorf("cannot compute the %s of a non-square matrix", operation)
+	}
+	if !m.IsSymmetric(vector.Tolerance[T](symmetryTolerance) * math.Max(1.0, m.maxAbs())) {
+		return fmt.Errorf("matrix is not symmetric, cannot compute the %s", operation)
+	}
+
+	return nil
+}
+
+// Computes and returns the eigen-decomposition of a symmetric matrix.
+//
+// It uses the cyclic Jacobi eigenvalue algorithm, which is accurate and always
+// yields an orthonormal set of eigenvectors, even for repeated eigenvalues.
+// Returns an error if the matrix is not square or not symmetric, with a tolerance
+// relative to its largest element. The original matrix is not modified.
+func (m *MatrixOf[T]) EigenSym() (*EigenSym, error) {
+	if err := m.checkSymmetric("symmetric eigen-decomposition"); err != nil {
+		return nil, err
+	}
+
+	n := m.nbRows
+	a := float64Of(m).Copy()
+	// Enforce exact symmetry, rounding errors would otherwise accumulate
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			mean := (a.row(i)[j] + a.row(j)[i]) / 2.0
+			a.row(i)[j] = mean
+			a.row(j)[i] = mean
+		}
+	}
+
+	return jacobiEigen(a), nil
+}
+
+// Computes the eigen-decomposition of an exactly symmetric matrix with the
+// cyclic Jacobi eigenvalue algorithm, overwriting it.
+func jacobiEigen(a *Matrix) *EigenSym {
+	n := a.nbRows
+	v := NewIdentity(n)
+
+	total := a.frobeniusSquared()
+	for sweep := 0; sweep < maxJacobiSweeps; sweep++ {
+		if a.offDiagonalSquared() <= machineEpsilon*machineEpsilon*total {
+			break
+		}
+		for p := 0; p < n-1; p++ {
+			for q := p + 1; q < n; q++ {
+				if a.row(p)[q] == 0.0 {
+					continue
+				}
+
+				// Rotation annihilating a[p][q]
+				theta := (a.row(q)[q] - a.row(p)[p]) / (2.0 * a.row(p)[q])
+				t := 1.0 / (math.Abs(theta) + math.Sqrt(theta*theta+1.0))
+				if theta < 0 {
+					t = -t
+				}
+				c := 1.0 / math.Sqrt(t*t+1.0)
+				s := t * c
+
+				rotateColumns(a, p, q, c, s)
+				rotateRows(a, p, q, c, s)
+				rotateColumns(v, p, q, c, s)
+				a.row(p)[q] = 0.0
+				a.row(q)[p] = 0.0
+			}
+		}
+	}
+
+	// Sort by non-decreasing eigenvalue
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(x, y int) bool {
+		return a.row(order[x])[order[x]] < a.row(order[y])[order[y]]
+	})
+	values := make([]float64, n)
+	vectors := New(n, n)
+	for j, col := range order {
+		values[j] = a.row(col)[col]
+		for i := 0; i < n; i++ {
+			vectors.row(i)[j] = v.row(i)[col]
+		}
+	}
+
+	return &EigenSym{values: values, vectors: vectors}
+}
+
+// Applies the Jacobi rotation of parameters (c, s) to the rows p and q of m.
+func rotateRows(m *Matrix, p int, q int, c float64, s float64) {
+	for j := 0; j < m.nbCols; j++ {
+		mp := m.row(p)[j]
+		mq := m.row(q)[j]
+		m.row(p)[j] = c*mp - s*mq
+		m.row(q)[j] = s*mp + c*mq
+	}
+}
+
+// Returns the sum of the squares of all the elements of the matrix.
+func (m *MatrixOf[T]) frobeniusSquared() float64 {
+	sum := 0.0
+	for i := 0; i < m.nbRows; i++ {
+		for _, val := range m.row(i) {
+			sum += float64(val) * float64(val)
+		}
+	}
+
+	return sum
+}
+
+// Returns the sum of the squares of the off-diagonal elements of the matrix.
+func (m *MatrixOf[T]) offDiagonalSquared() float64 {
+	sum := 0.0
+	for i := 0; i < m.nbRows; i++ {
+		for j, val := range m.row(i) {
+			if i != j {
+				sum += float64(val) * float64(val)
+			}
+		}
+	}
+
+	return sum
+}
+
+// Returns the eigenvalues, sorted in non-decreasing order.
+//
+// The returned slice is a copy.
+func (e *EigenSym) Values() []float64 {
+	values := make([]float64, len(e.values))
+	copy(values, e.values)
+
+	return values
+}
+
+// Returns the orthogonal matrix whose columns are the unit eigenvectors,
+// in the same order as the eigenvalues.
+func (e *EigenSym) Vectors() *Matrix {
+	return e.vectors.Copy()
+}
@@ -0,0 +1,94 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsSymmetric(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{1, 2},
+		{2, 3},
+	})
+	almost, _ := NewFromData([][]float64{
+		{1, 2},
+		{2.001, 3},
+	})
+
+	assert.True(t, m.IsSymmetric(0))
+	assert.False(t, almost.IsSymmetric(1e-6))
+	assert.True(t, almost.IsSymmetric(1e-2))
+	assert.False(t, New(2, 3).IsSymmetric(1e-2))
+	assert.True(t, New(0, 0).IsSymmetric(0))
+}
+
+func TestEigenSym_ShouldFail_NonSymmetric(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{1, 2},
+		{3, 4},
+	})
+
+	_, err := m.EigenSym()
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not symmetric")
+}
+
+func TestEigenSym_ShouldFail_NonSquare(t *testing.T) {
+	_, err := New(2, 3).EigenSym()
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "non-square")
+}
+
+func TestEigenSym_KnownEigenvalues(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{2, -1, 0},
+		{-1, 2, -1},
+		{0, -1, 2},
+	})
+
+	eig, err := m.EigenSym()
+	require.NoError(t, err)
+
+	expected := []float64{2 - math.Sqrt2, 2, 2 + math.Sqrt2}
+	assert.InDeltaSlice(t, expected, eig.Values(), 1e-9)
+}
+
+func TestEigenSym_ReconstructsMatrix(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{4, 1, -2, 2},
+		{1, 2, 0, 1},
+		{-2, 0, 3, -2},
+		{2, 1, -2, -1},
+	})
+
+	eig, err := m.EigenSym()
+	require.NoError(t, err)
+
+	v := eig.Vectors()
+	vtv, _ := v.Transpose().Mul(v)
+	assert.True(t, vtv.EqualsApprox(NewIdentity(4), 1e-9))
+
+	vd, _ := v.Mul(NewDiagonal(eig.Values()))
+	vdvt, _ := vd.Mul(v.Transpose())
+	assert.True(t, vdvt.EqualsApprox(m, 1e-9), "expected %v, got %v", m, vdvt)
+
+	values := eig.Values()
+	for i := 1; i < len(values); i++ {
+		assert.LessOrEqual(t, values[i-1], values[i])
+	}
+}
+
+func TestEigenSym_RepeatedEigenvalues(t *testing.T) {
+	m := NewIdentity(3).MulScalar(5)
+
+	eig, err := m.EigenSym()
+	require.NoError(t, err)
+
+	assert.InDeltaSlice(t, []float64{5, 5, 5}, eig.Values(), 1e-12)
+	assert.True(t, eig.Vectors().IsIdentity())
+}
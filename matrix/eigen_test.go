@@ -0,0 +1,165 @@
+package matrix
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Checks that A * v = λ * v holds for every eigenpair of the decomposition
+func assertEigenPairs(t *testing.T, m *Matrix, eig *Eigen) {
+	values := eig.Values()
+	vectors := eig.Vectors()
+	n := m.nbRows
+	for j := 0; j < n; j++ {
+		for i := 0; i < n; i++ {
+			av := complex(0, 0)
+			for k := 0; k < n; k++ {
+				av += complex(m.GetElementAt(i, k), 0) * vectors[k][j]
+			}
+			assert.InDelta(t, 0.0, cmplx.Abs(av-values[j]*vectors[i][j]), 1e-9,
+				"eigenpair %d does not satisfy A * v = λ * v", j)
+		}
+	}
+}
+
+func TestEigen_ShouldFail_NonSquare(t *testing.T) {
+	_, err := New(3, 2).Eigen()
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "non-square")
+}
+
+func TestEigen_RealEigenvalues(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{4, 1},
+		{2, 3},
+	})
+
+	eig, err := m.Eigen()
+	require.NoError(t, err)
+
+	assert.True(t, eig.IsReal())
+	values := eig.Values()
+	assert.ElementsMatch(t, []float64{2, 5}, []float64{
+		math.Round(real(values[0])*1e9) / 1e9,
+		math.Round(real(values[1])*1e9) / 1e9,
+	})
+	assertEigenPairs(t, m, eig)
+}
+
+func TestEigen_ComplexEigenvalues(t *testing.T) {
+	// Rotation by 90 degrees
+	m, _ := NewFromData([][]float64{
+		{0, -1},
+		{1, 0},
+	})
+
+	eig, err := m.Eigen()
+	require.NoError(t, err)
+
+	values := eig.Values()
+	assert.False(t, eig.IsReal())
+	assert.InDelta(t, 0.0, cmplx.Abs(values[0]-1i), 1e-12)
+	assert.InDelta(t, 0.0, cmplx.Abs(values[1]+1i), 1e-12)
+	assertEigenPairs(t, m, eig)
+}
+
+func TestEigen_LargerNonSymmetricMatrix(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{1, 2, 0, 4},
+		{-3, 1, 5, 0},
+		{2, -1, 3, 1},
+		{0, 4, -2, 2},
+	})
+
+	eig, err := m.Eigen()
+	require.NoError(t, err)
+
+	// Sum of eigenvalues is the trace, their product the determinant
+	sum := complex(0, 0)
+	product := complex(1, 0)
+	for _, val := range eig.Values() {
+		sum += val
+		product *= val
+	}
+	det, _ := m.Determinant()
+	assert.InDelta(t, 7.0, real(sum), 1e-9)
+	assert.InDelta(t, 0.0, imag(sum), 1e-9)
+	assert.InDelta(t, det, real(product), 1e-8)
+	assertEigenPairs(t, m, eig)
+}
+
+func TestDiagonalize_ShouldSucceed(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{2, 0, 0},
+		{1, 3, 0},
+		{4, -1, 5},
+	})
+
+	p, d, err := m.Diagonalize()
+	require.NoError(t, err)
+
+	assert.True(t, d.IsDiagonal())
+	pInv, err := p.Invert()
+	require.NoError(t, err)
+	pd, _ := p.Mul(d)
+	pdp, _ := pd.Mul(pInv)
+	assert.True(t, pdp.EqualsApprox(m, 1e-9), "expected %v, got %v", m, pdp)
+}
+
+func TestDiagonalize_ShouldSucceed_IllConditioned(t *testing.T) {
+	// Close eigenvalues, whose eigenvectors are nearly (but not numerically) parallel
+	m, _ := NewFromData([][]float64{
+		{1, 1},
+		{0, 1 + 1e-11},
+	})
+
+	p, d, err := m.Diagonalize()
+	require.NoError(t, err)
+
+	// P is too ill-conditioned for Invert, check A * P = P * D instead
+	ap, _ := m.Mul(p)
+	pd, _ := p.Mul(d)
+	assert.True(t, ap.EqualsApprox(pd, 1e-12), "expected %v, got %v", pd, ap)
+	assert.InDelta(t, 1.0, math.Min(d.GetElementAt(0, 0), d.GetElementAt(1, 1)), 1e-12)
+	assert.InDelta(t, 1+1e-11, math.Max(d.GetElementAt(0, 0), d.GetElementAt(1, 1)), 1e-12)
+}
+
+func TestDiagonalize_ShouldFail_ComplexEigenvalues(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{0, -1},
+		{1, 0},
+	})
+
+	_, _, err := m.Diagonalize()
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "complex eigenvalues")
+}
+
+func TestDiagonalize_ShouldFail_Defective(t *testing.T) {
+	// Jordan block
+	m, _ := NewFromData([][]float64{
+		{1, 1},
+		{0, 1},
+	})
+
+	// Larger Jordan block
+	jordan3 := NewFromFlat(3, 3, []float64{
+		2, 1, 0,
+		0, 2, 1,
+		0, 0, 2,
+	})
+
+	_, _, err := m.Diagonalize()
+	_, _, err3 := jordan3.Diagonalize()
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "defective")
+	assert.Error(t, err3)
+	assert.Contains(t, err3.Error(), "defective")
+}
@@ -0,0 +1,367 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+)
+
+// Largest 1-norms for which the Padé approximants of degree 3, 5, 7, 9 and 13
+// give the exponential to float64 precision (Higham, 2005).
+var expPadeThetas = [...]float64{
+	1.495585217958292e-2,
+	2.539398330063230e-1,
+	9.504178996162932e-1,
+	2.097847961257068e0,
+	5.371920351148152e0,
+}
+
+// Coefficients of the numerator of the Padé approximants of degree 3, 5, 7, 9 and 13
+// of the exponential, in increasing order of the power. The denominator
+// has the same coefficients with alternating signs.
+var expPadeCoefficients = [...][]float64{
+	{120, 60, 12, 1},
+	{30240, 15120, 3360, 420, 30, 1},
+	{17297280, 8648640, 1995840, 277200, 25200, 1512, 56, 1},
+	{17643225600, 8821612800, 2075673600, 302702400, 30270240, 2162160, 110880, 3960, 90, 1},
+	{
+		64764752532480000, 32382376266240000, 7771770303897600, 1187353796428800,
+		129060195264000, 10559470521600, 670442572800, 33522128640, 1323241920,
+		40840800, 960960, 16380, 182, 1,
+	},
+}
+
+// Largest 1-norm of X for which log(I + X) is computed with the Padé approximant
+// of degree 8, which is accurate to float64 precision up to about 0.37.
+const logPadeRadius = 0.25
+
+// Nodes and weights of the 8-point Gauss-Legendre quadrature on [-1, 1],
+// giving the partial fraction form of the Padé approximant of degree 8 of log(1 + x).
+var (
+	gaussLegendreNodes = [...]float64{
+		-0.9602898564975363, -0.7966664774136267, -0.5255324099163290, -0.1834346424956498,
+		0.1834346424956498, 0.5255324099163290, 0.7966664774136267, 0.9602898564975363,
+	}
+	gaussLegendreWeights = [...]float64{
+		0.1012285362903763, 0.2223810344533745, 0.3137066458778873, 0.3626837833783620,
+		0.3626837833783620, 0.3137066458778873, 0.2223810344533745, 0.1012285362903763,
+	}
+)
+
+// Maximum number of Denman-Beavers iterations for a square root
+const maxSqrtmIterations = 100
+
+// Maximum number of square roots taken by Logm to bring the matrix close to the identity
+const maxLogmSquareRoots = 64
+
+// Returns the exponential of the matrix, namely the sum of A^k / k! for k >= 0.
+//
+// It uses the scaling and squaring method with Padé approximants of Higham:
+// the degree of the approximant is chosen from the 1-norm of A, and A is scaled
+// by a power of 2 when even the degree 13 is not enough, the result being
+// squared back as many times.
+// For instance, the solution of the linear system x' = A * x is e^(A*t) * x(0).
+//
+// Returns an error if the matrix is not square or has non-finite elements.
+// The exponential is computed in float64. The original matrix is not modified.
+func (m *MatrixOf[T]) Expm() (*MatrixOf[T], error) {
+	if !m.IsSquare() {
+		return nil, fmt.Errorf("cannot compute the exponential of a non-square matrix")
+	}
+	if m.nbRows == 0 {
+		return NewOf[T](0, 0), nil
+	}
+
+	a := float64Of(m).Copy()
+	norm := a.transposedNormInf()
+	if math.IsNaN(norm) || math.IsInf(norm, 0) {
+		return nil, fmt.Errorf("cannot compute the exponential of a matrix with non-finite elements")
+	}
+
+	// Low degrees are enough for small norms
+	for i, theta := range expPadeThetas[:len(expPadeThetas)-1] {
+		if norm <= theta {
+			exp, err := expPade(a, expPadeCoefficients[i])
+			if err != nil {
+				return nil, err
+			}
+			return fromFloat64[T](exp), nil
+		}
+	}
+
+	// Scale A so that the degree 13 is enough, then square the result back
+	squarings := 0
+	if theta := expPadeThetas[len(expPadeThetas)-1]; norm > theta {
+		squarings = int(math.Ceil(math.Log2(norm / theta)))
+		a.MulScalarInPlace(math.Ldexp(1.0, -squarings))
+	}
+	exp, err := expPade13(a)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < squarings; i++ {
+		exp = product(exp, exp)
+	}
+
+	return fromFloat64[T](exp), nil
+}
+
+// Returns the Padé approximant of e^A of odd degree len(coefficients) - 1,
+// evaluated from the powers of A^2.
+func expPade(a *Matrix, coefficients []float64) (*Matrix, error) {
+	n := a.nbRows
+	a2 := product(a, a)
+
+	// Even powers of A, from A^0 to A^(degree - 1)
+	powers := []*Matrix{NewIdentity(n)}
+	for len(powers) < len(coefficients)/2 {
+		powers = append(powers, product(powers[len(powers)-1], a2))
+	}
+	oddCoefficients := make([]float64, len(powers))
+	evenCoefficients := make([]float64, len(powers))
+	for k := range powers {
+		evenCoefficients[k] = coefficients[2*k]
+		oddCoefficients[k] = coefficients[2*k+1]
+	}
+
+	u := product(a, linearCombination(oddCoefficients, powers...))
+	v := linearCombination(evenCoefficients, powers...)
+
+	return padeQuotient(u, v)
+}
+
+// Returns the Padé approximant of e^A of degree 13, evaluated with
+// only A^2, A^4 and A^6 as in Higham's algorithm.
+func expPade13(a *Matrix) (*Matrix, error) {
+	b := expPadeCoefficients[len(expPadeCoefficients)-1]
+	identity := NewIdentity(a.nbRows)
+	a2 := product(a, a)
+	a4 := product(a2, a2)
+	a6 := product(a4, a2)
+
+	u := product(a6, linearCombination([]float64{b[13], b[11], b[9]}, a6, a4, a2))
+	u = product(a, linearCombination([]float64{1, b[7], b[5], b[3], b[1]}, u, a6, a4, a2, identity))
+	v := product(a6, linearCombination([]float64{b[12], b[10], b[8]}, a6, a4, a2))
+	v = linearCombination([]float64{1, b[6], b[4], b[2], b[0]}, v, a6, a4, a2, identity)
+
+	return padeQuotient(u, v)
+}
+
+// Returns (V - U)^-1 * (V + U), where U and V are the odd and even parts
+// of the numerator of a Padé approximant of the exponential.
+func padeQuotient(u, v *Matrix) (*Matrix, error) {
+	numerator := linearCombination([]float64{1, 1}, v, u)
+	denominator := linearCombination([]float64{1, -1}, v, u)
+
+	lu, _ := denominator.LU()
+	result, err := lu.SolveMatrix(numerator)
+	if err != nil {
+		return nil, fmt.Errorf("cannot compute the exponential: %w", err)
+	}
+
+	return result, nil
+}
+
+// Returns the principal square root of the matrix, namely the matrix X whose
+// eigenvalues have positive real parts and such that X * X = A.
+//
+// It uses the Denman-Beavers iteration with determinant scaling, which
+// converges quadratically to the square root when A has no eigenvalue on
+// the closed negative real axis.
+//
+// Returns an error if the matrix is not square, an error wrapping ErrSingular
+// if it (or an iterate) is singular, or an error if the iteration does not
+// converge, for instance when A has negative eigenvalues.
+// The square root is computed in float64. The original matrix is not modified.
+func (m *MatrixOf[T]) Sqrtm() (*MatrixOf[T], error) {
+	if !m.IsSquare() {
+		return nil, fmt.Errorf("cannot compute the square root of a non-square matrix")
+	}
+	if m.nbRows == 0 {
+		return NewOf[T](0, 0), nil
+	}
+
+	sqrt, err := denmanBeavers(float64Of(m))
+	if err != nil {
+		return nil, err
+	}
+
+	return fromFloat64[T](sqrt), nil
+}
+
+// Computes the principal square root of a square matrix with the Denman-Beavers
+// iteration Y <- (Y + Z^-1) / 2 and Z <- (Z + Y^-1) / 2, starting from Y = A and
+// Z = I, where Y converges to A^(1/2) and Z to A^(-1/2).
+//
+// Until the iterates get close, they are scaled by |det(Y) * det(Z)|^(-1/(2n)),
+// which cuts down the number of iterations when the eigenvalues of A are spread out.
+// The iterates are inverted with a singularity test relative to their scale, so
+// that matrices with tiny entries, such as 1e-12 * I, keep their square root.
+func denmanBeavers(a *Matrix) (*Matrix, error) {
+	n := a.nbRows
+	y := a.Copy()
+	z := NewIdentity(n)
+	tolerance := float64(n) * machineEpsilon
+	scaling := true
+	previousChange := math.Inf(1)
+
+	for k := 0; k < maxSqrtmIterations; k++ {
+		yLU, _ := y.LU()
+		zLU, _ := z.LU()
+		yInv, err := yLU.scaledInverse()
+		if err != nil {
+			return nil, fmt.Errorf("cannot compute the square root: %w", err)
+		}
+		zInv, err := zLU.scaledInverse()
+		if err != nil {
+			return nil, fmt.Errorf("cannot compute the square root: %w", err)
+		}
+
+		mu := 1.0
+		if scaling {
+			mu = math.Pow(math.Abs(yLU.Determinant()*zLU.Determinant()), -1.0/float64(2*n))
+			if math.IsNaN(mu) || math.IsInf(mu, 0) || mu == 0.0 {
+				mu = 1.0
+			}
+		}
+		next := linearCombination([]float64{mu / 2, 1 / (2 * mu)}, y, zInv)
+		z = linearCombination([]float64{mu / 2, 1 / (2 * mu)}, z, yInv)
+
+		difference := linearCombination([]float64{1, -1}, next, y)
+		change := math.Sqrt(difference.frobeniusSquared() / next.frobeniusSquared())
+		y = next
+		// Converged, or stagnating at the level of rounding errors
+		if change <= tolerance || (change < 1e-8 && change >= previousChange) {
+			return y, nil
+		}
+		if change < 1e-2 {
+			scaling = false
+		}
+		previousChange = change
+	}
+
+	return nil, fmt.Errorf("cannot compute the square root: Denman-Beavers iteration did not converge in %d iterations", maxSqrtmIterations)
+}
+
+// Returns the principal logarithm of the matrix, namely the matrix X whose
+// eigenvalues have imaginary parts in (-π, π) and such that e^X = A.
+//
+// It uses the inverse scaling and squaring method: square roots are taken
+// until A^(1/2^k) is close enough to the identity for the Padé approximant
+// of degree 8 of log(I + X), evaluated in partial fraction form, to be accurate.
+// The result is then scaled back by 2^k.
+//
+// The principal logarithm is real when A has no eigenvalue on the closed negative
+// real axis. Returns an error if the matrix is not square, or if one of the square
+// roots cannot be computed (see Sqrtm), in particular when A is singular or
+// has negative eigenvalues.
+// The logarithm is computed in float64. The original matrix is not modified.
+func (m *MatrixOf[T]) Logm() (*MatrixOf[T], error) {
+	if !m.IsSquare() {
+		return nil, fmt.Errorf("cannot compute the logarithm of a non-square matrix")
+	}
+	n := m.nbRows
+	if n == 0 {
+		return NewOf[T](0, 0), nil
+	}
+
+	a := float64Of(m).Copy()
+	identity := NewIdentity(n)
+	x := linearCombination([]float64{1, -1}, a, identity)
+	squareRoots := 0
+	for x.transposedNormInf() > logPadeRadius {
+		if squareRoots == maxLogmSquareRoots {
+			return nil, fmt.Errorf("cannot compute the logarithm: matrix is still far from the identity after %d square roots", maxLogmSquareRoots)
+		}
+		var err error
+		a, err = denmanBeavers(a)
+		if err != nil {
+			return nil, fmt.Errorf("cannot compute the logarithm: %w", err)
+		}
+		x = linearCombination([]float64{1, -1}, a, identity)
+		squareRoots++
+	}
+
+	// log(I + X) ≈ sum of w_j * X * (I + t_j * X)^-1, where t_j are the
+	// quadrature nodes mapped to [0, 1]
+	log := New(n, n)
+	for j, node := range gaussLegendreNodes {
+		t := (node + 1.0) / 2.0
+		lu, _ := linearCombination([]float64{1, t}, identity, x).LU()
+		term, err := lu.SolveMatrix(x)
+		if err != nil {
+			return nil, fmt.Errorf("cannot compute the logarithm: %w", err)
+		}
+		log = linearCombination([]float64{1, gaussLegendreWeights[j] / 2.0}, log, term)
+	}
+	log.MulScalarInPlace(math.Ldexp(1.0, squareRoots))
+
+	return fromFloat64[T](log), nil
+}
+
+// Returns f(A), where f is applied to the eigenvalues of the matrix.
+//
+// The matrix must be diagonalizable over the reals (see Diagonalize):
+// if A = P * D * P^-1, then f(A) = P * f(D) * P^-1, where f(D) is the diagonal
+// matrix of the f(λ_i). For instance, Funm(math.Exp) gives the same result as Expm.
+// The accuracy depends on the condition number of P, so Expm, Logm and Sqrtm
+// should be preferred for these functions.
+//
+// Returns an error if the matrix is not square, or if it is not diagonalizable
+// over the reals. The result is computed in float64. The original matrix is not modified.
+func (m *MatrixOf[T]) Funm(f func(float64) float64) (*MatrixOf[T], error) {
+	if !m.IsSquare() {
+		return nil, fmt.Errorf("cannot compute a function of a non-square matrix")
+	}
+	n := m.nbRows
+	if n == 0 {
+		return NewOf[T](0, 0), nil
+	}
+
+	p, d, err := float64Of(m).Diagonalize()
+	if err != nil {
+		return nil, fmt.Errorf("cannot compute a function of the matrix: %w", err)
+	}
+
+	// P * f(D) scales the columns of P
+	scaled := p.Copy()
+	for j := 0; j < n; j++ {
+		value := f(d.row(j)[j])
+		for i := 0; i < n; i++ {
+			scaled.row(i)[j] *= value
+		}
+	}
+	// X * P = P * f(D) is solved as P^T * X^T = (P * f(D))^T, where P is
+	// known to be well enough conditioned since Diagonalize succeeded
+	lu, _ := p.Transpose().LU()
+	result := lu.solve(scaled.Transpose())
+
+	return fromFloat64[T](result.Transpose()), nil
+}
+
+// Returns the product a * b.
+//
+// This helper function assumes the dimensions agree.
+func product(a, b *Matrix) *Matrix {
+	result := New(a.nbRows, b.nbCols)
+	mulInto(result, a, b)
+
+	return result
+}
+
+// Returns the sum of coefficients[k] * terms[k].
+//
+// This helper function assumes there are as many coefficients as terms,
+// and that all terms have the same dimensions.
+func linearCombination(coefficients []float64, terms ...*Matrix) *Matrix {
+	result := New(terms[0].nbRows, terms[0].nbCols)
+	for k, term := range terms {
+		for i := 0; i < result.nbRows; i++ {
+			resultRow := result.row(i)
+			for j, val := range term.row(i) {
+				resultRow[j] += coefficients[k] * val
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,260 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Rotation by the given angle, whose exponential and logarithm are known
+func newTestRotationGenerator(angle float64) *Matrix {
+	return NewFromFlat(2, 2, []float64{0, -angle, angle, 0})
+}
+
+func newTestRotation(angle float64) *Matrix {
+	return NewFromFlat(2, 2, []float64{
+		math.Cos(angle), -math.Sin(angle),
+		math.Sin(angle), math.Cos(angle),
+	})
+}
+
+func TestExpm_Diagonal(t *testing.T) {
+	// Norms covering each degree of the Padé approximant, and scaling
+	for _, scale := range []float64{1e-3, 0.1, 0.5, 1.5, 4, 50} {
+		m := NewDiagonal([]float64{scale, -scale, 0})
+		expected := NewDiagonal([]float64{math.Exp(scale), math.Exp(-scale), 1})
+
+		exp, err := m.Expm()
+		require.NoError(t, err)
+
+		assert.True(t, exp.EqualsApprox(expected, 1e-13*math.Exp(scale)), "scale %v: got %v", scale, exp)
+	}
+}
+
+func TestExpm_Rotation(t *testing.T) {
+	exp, err := newTestRotationGenerator(2.0).Expm()
+	require.NoError(t, err)
+
+	assert.True(t, exp.EqualsApprox(newTestRotation(2.0), 1e-13), "got %v", exp)
+}
+
+func TestExpm_Nilpotent(t *testing.T) {
+	m := NewFromFlat(3, 3, []float64{
+		0, 1, 2,
+		0, 0, 3,
+		0, 0, 0,
+	})
+	// e^N = I + N + N^2 / 2
+	expected := NewFromFlat(3, 3, []float64{
+		1, 1, 3.5,
+		0, 1, 3,
+		0, 0, 1,
+	})
+
+	exp, err := m.Expm()
+	require.NoError(t, err)
+
+	assert.True(t, exp.EqualsApprox(expected, 1e-13), "got %v", exp)
+}
+
+func TestExpm_MarkovGenerator(t *testing.T) {
+	// Rows of the generator of a continuous-time Markov chain sum to zero,
+	// so the transition matrix e^(Q*t) is stochastic
+	q := NewFromFlat(3, 3, []float64{
+		-3, 2, 1,
+		1, -1, 0,
+		4, 4, -8,
+	})
+
+	transition, err := q.MulScalar(10).Expm()
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		row, _ := transition.Row(i)
+		sum := 0.0
+		for _, val := range row.GetData() {
+			assert.GreaterOrEqual(t, val, 0.0)
+			sum += val
+		}
+		assert.InDelta(t, 1.0, sum, 1e-12)
+	}
+}
+
+func TestExpm_Errors(t *testing.T) {
+	_, err := New(2, 3).Expm()
+	assert.Error(t, err)
+	_, err = NewFromFlat(1, 1, []float64{math.Inf(1)}).Expm()
+	assert.Error(t, err)
+
+	empty, err := New(0, 0).Expm()
+	require.NoError(t, err)
+	assert.Equal(t, 0, empty.GetNbRows())
+}
+
+func TestSqrtm(t *testing.T) {
+	m := NewFromFlat(3, 3, []float64{
+		4, 1, 0,
+		1, 5, 2,
+		0, 2, 100,
+	})
+
+	sqrt, err := m.Sqrtm()
+	require.NoError(t, err)
+	square, _ := sqrt.Mul(sqrt)
+
+	assert.True(t, square.EqualsApprox(m, 1e-11), "got %v", square)
+	// The principal square root of a symmetric positive definite matrix is too
+	assert.True(t, sqrt.IsPositiveDefinite())
+}
+
+func TestSqrtm_NonSymmetric(t *testing.T) {
+	m := NewFromFlat(2, 2, []float64{
+		1, 4,
+		0, 9,
+	})
+	expected := NewFromFlat(2, 2, []float64{
+		1, 1,
+		0, 3,
+	})
+
+	sqrt, err := m.Sqrtm()
+	require.NoError(t, err)
+
+	assert.True(t, sqrt.EqualsApprox(expected, 1e-12), "got %v", sqrt)
+}
+
+func TestSqrtm_SmallScale(t *testing.T) {
+	// Below the absolute pivot tolerance, but perfectly conditioned
+	m := NewIdentity(3).MulScalar(1e-12)
+
+	sqrt, err := m.Sqrtm()
+	require.NoError(t, err)
+
+	assert.True(t, sqrt.EqualsApprox(NewIdentity(3).MulScalar(1e-6), 1e-18), "got %v", sqrt)
+}
+
+func TestSqrtm_Errors(t *testing.T) {
+	singular := NewFromFlat(2, 2, []float64{1, 2, 2, 4})
+
+	_, err := singular.Sqrtm()
+	assert.True(t, errors.Is(err, ErrSingular))
+	_, err = NewDiagonal([]float64{-1, 4}).Sqrtm()
+	assert.Error(t, err)
+	_, err = New(3, 2).Sqrtm()
+	assert.Error(t, err)
+}
+
+func TestLogm(t *testing.T) {
+	m := NewDiagonal([]float64{1, math.E, 1e6})
+	expected := NewDiagonal([]float64{0, 1, math.Log(1e6)})
+
+	log, err := m.Logm()
+	require.NoError(t, err)
+
+	assert.True(t, log.EqualsApprox(expected, 1e-10), "got %v", log)
+}
+
+func TestLogm_Rotation(t *testing.T) {
+	log, err := newTestRotation(2.5).Logm()
+	require.NoError(t, err)
+
+	assert.True(t, log.EqualsApprox(newTestRotationGenerator(2.5), 1e-11), "got %v", log)
+}
+
+func TestLogm_InverseOfExpm(t *testing.T) {
+	m := NewFromFlat(3, 3, []float64{
+		0.5, 1, 0,
+		-0.2, 0.1, 0.3,
+		0, 0.4, -1,
+	})
+
+	exp, err := m.Expm()
+	require.NoError(t, err)
+	log, err := exp.Logm()
+	require.NoError(t, err)
+
+	assert.True(t, log.EqualsApprox(m, 1e-11), "got %v", log)
+}
+
+func TestLogm_Errors(t *testing.T) {
+	_, err := NewDiagonal([]float64{-1, 1}).Logm()
+	assert.Error(t, err)
+	_, err = NewFromFlat(2, 2, []float64{1, 2, 2, 4}).Logm()
+	assert.Error(t, err)
+	_, err = New(1, 2).Logm()
+	assert.Error(t, err)
+}
+
+func TestFunm(t *testing.T) {
+	m := NewFromFlat(2, 2, []float64{
+		2, 1,
+		1, 2,
+	})
+
+	exp, err := m.Funm(math.Exp)
+	require.NoError(t, err)
+	expected, err := m.Expm()
+	require.NoError(t, err)
+	sqrt, err := m.Funm(math.Sqrt)
+	require.NoError(t, err)
+	square, _ := sqrt.Mul(sqrt)
+
+	assert.True(t, exp.EqualsApprox(expected, 1e-12), "got %v", exp)
+	assert.True(t, square.EqualsApprox(m, 1e-12))
+}
+
+func TestFunm_IllConditionedEigenvectors(t *testing.T) {
+	// Diagonalizable, but with nearly parallel eigenvectors
+	m := NewFromFlat(2, 2, []float64{
+		1, 1,
+		0, 1 + 1e-11,
+	})
+
+	exp, err := m.Funm(math.Exp)
+	require.NoError(t, err)
+	expected, err := m.Expm()
+	require.NoError(t, err)
+
+	// The error grows with the condition number of the eigenvectors, here about 1e11
+	assert.True(t, exp.EqualsApprox(expected, 1e-3), "got %v", exp)
+}
+
+func TestFunm_Errors(t *testing.T) {
+	// Complex eigenvalues
+	_, err := newTestRotationGenerator(1).Funm(math.Exp)
+	assert.Error(t, err)
+	// Defective
+	_, err = NewFromFlat(2, 2, []float64{1, 1, 0, 1}).Funm(math.Exp)
+	assert.Error(t, err)
+	_, err = New(2, 1).Funm(math.Exp)
+	assert.Error(t, err)
+}
+
+func TestMatrixFunctions_Float32(t *testing.T) {
+	m := Convert[float32](newTestRotationGenerator(1.0))
+
+	exp, err := m.Expm()
+	require.NoError(t, err)
+	log, err := exp.Logm()
+	require.NoError(t, err)
+
+	assert.True(t, exp.EqualsApprox(Convert[float32](newTestRotation(1.0)), 1e-6))
+	assert.True(t, log.EqualsApprox(m, 1e-5))
+}
+
+func TestPow_LargePower(t *testing.T) {
+	shear := NewFromFlat(2, 2, []float64{1, 1, 0, 1})
+	fibonacci := NewFromFlat(2, 2, []float64{1, 1, 1, 0})
+
+	result, err := shear.Pow(1000)
+	require.NoError(t, err)
+	fib, err := fibonacci.Pow(50)
+	require.NoError(t, err)
+
+	assert.True(t, result.EqualsApprox(NewFromFlat(2, 2, []float64{1, 1000, 0, 1}), 0))
+	// F(51), F(50), F(49)
+	assert.True(t, fib.EqualsApprox(NewFromFlat(2, 2, []float64{20365011074, 12586269025, 12586269025, 7778742049}), 0))
+}
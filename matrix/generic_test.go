@@ -0,0 +1,172 @@
+package matrix
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/JoLandry/linalgo/vector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMatrix32() *Matrix32 {
+	m, _ := NewFromDataOf([][]float32{
+		{4, 3, 0},
+		{6, 3, 1},
+		{0, 2, 5},
+	})
+	return m
+}
+
+func TestNewOf_Float32(t *testing.T) {
+	m := NewOf[float32](2, 3)
+	id := NewIdentityOf[float32](2)
+	diag := NewDiagonalOf([]float32{1, 2})
+	flat := NewFromFlatOf(1, 2, []float32{1.5, 2.5})
+
+	assert.Equal(t, 2, m.GetNbRows())
+	assert.Equal(t, 3, m.GetNbCols())
+	assert.True(t, m.IsZero())
+	assert.True(t, id.IsIdentity())
+	assert.True(t, diag.IsDiagonal())
+	assert.Equal(t, [][]float32{{1.5, 2.5}}, flat.GetData())
+	_, err := NewFromDataOf([][]float32{{1, 2}, {3}})
+	assert.Error(t, err)
+}
+
+func TestConvert_RoundTrip(t *testing.T) {
+	m, _ := NewFromData([][]float64{{1.5, -2}, {0.25, 8}})
+
+	single := Convert[float32](m)
+	double := Convert[float64](single)
+
+	assert.Equal(t, [][]float32{{1.5, -2}, {0.25, 8}}, single.GetData())
+	assert.True(t, double.EqualsApprox(m, 0))
+}
+
+func TestMatrix32_ArithmeticMatchesFloat64(t *testing.T) {
+	m := newTestMatrix32()
+	reference := Convert[float64](m)
+
+	sum, err := m.Add(m)
+	require.NoError(t, err)
+	product, err := m.Mul(m.Transpose())
+	require.NoError(t, err)
+	expected, _ := reference.Mul(reference.Transpose())
+	power, err := m.Pow(3)
+	require.NoError(t, err)
+	expectedPower, _ := reference.Pow(3)
+
+	assert.True(t, sum.EqualsApprox(m.MulScalar(2), 0))
+	assert.True(t, Convert[float64](product).EqualsApprox(expected, 0))
+	assert.True(t, Convert[float64](power).EqualsApprox(expectedPower, 0))
+}
+
+func TestMatrix32_MulVecAndApply(t *testing.T) {
+	m := newTestMatrix32()
+	v := vector.NewFromDataOf([]float32{1, -1, 2})
+	y := vector.NewOf[float32](3)
+
+	result, err := m.MulVec(v)
+	require.NoError(t, err)
+	var op LinearOperatorOf[float32] = m
+	require.NoError(t, op.Apply(v, y))
+
+	assert.Equal(t, []float32{1, 5, 8}, result.GetData())
+	assert.Equal(t, result.GetData(), y.GetData())
+	require.NoError(t, m.T().Apply(v, y))
+	assert.Equal(t, []float32{-2, 4, 9}, y.GetData())
+}
+
+func TestMatrix32_DeterminantInvertSolve(t *testing.T) {
+	m := newTestMatrix32()
+
+	det, err := m.Determinant()
+	require.NoError(t, err)
+	inv, err := m.Invert()
+	require.NoError(t, err)
+	product, _ := m.Mul(inv)
+	b := vector.NewFromDataOf([]float32{7, 10, 7})
+	x, err := m.Solve(b)
+	require.NoError(t, err)
+
+	assert.InDelta(t, -38.0, float64(det), 1e-5)
+	assert.True(t, product.EqualsApprox(NewIdentityOf[float32](3), 1e-6))
+	assert.InDeltaSlice(t, []float32{1, 1, 1}, x.GetData(), 1e-6)
+}
+
+func TestMatrix32_PivotToleranceScalesWithPrecision(t *testing.T) {
+	// Rows differ by far less than the precision of float32 inputs can justify,
+	// but by far more than the float64 pivot tolerance
+	m64, _ := NewFromData([][]float64{
+		{1, 2},
+		{1, 2 + 1e-6},
+	})
+	m32 := Convert[float32](m64)
+
+	lu64, err := m64.LU()
+	require.NoError(t, err)
+	lu32, err := m32.LU()
+	require.NoError(t, err)
+
+	assert.False(t, lu64.IsSingular())
+	assert.True(t, lu32.IsSingular())
+	assert.True(t, m64.IsInvertible())
+	assert.False(t, m32.IsInvertible())
+	_, err = m32.Invert()
+	assert.True(t, errors.Is(err, ErrSingular))
+}
+
+func TestMatrix32_RankToleranceScalesWithPrecision(t *testing.T) {
+	m32, _ := NewFromDataOf([][]float32{
+		{1, 2, 3},
+		{2, 4, 6.0000005},
+		{1, 0, 1},
+	})
+
+	assert.Equal(t, 2, m32.Rank())
+	assert.Equal(t, 3, Convert[float64](m32).Rank())
+	assert.Equal(t, 2, m32.NumericalRank(0))
+}
+
+func TestMatrix32_Decompositions(t *testing.T) {
+	spd, _ := NewFromDataOf([][]float32{
+		{4, 1, 0},
+		{1, 3, 1},
+		{0, 1, 2},
+	})
+
+	chol, err := spd.Cholesky()
+	require.NoError(t, err)
+	eig, err := spd.EigenSym()
+	require.NoError(t, err)
+	reference, err := Convert[float64](spd).EigenSym()
+	require.NoError(t, err)
+
+	l := chol.L()
+	product, _ := l.Mul(l.Transpose())
+	assert.True(t, product.EqualsApprox(Convert[float64](spd), 1e-12))
+	assert.InDeltaSlice(t, reference.Values(), eig.Values(), 1e-12)
+	assert.True(t, spd.IsPositiveDefinite())
+	assert.InDelta(t, float64(spd.Norm2()), reference.Values()[2], 1e-5)
+	assert.True(t, spd.PseudoInverse().EqualsApprox(mustInvert(t, spd), 1e-5))
+}
+
+func TestMatrix32_ViewsAndInPlace(t *testing.T) {
+	m := newTestMatrix32()
+
+	view, err := m.Slice(1, 3, 1, 3)
+	require.NoError(t, err)
+	view.MulScalarInPlace(2)
+	row, err := m.Row(2)
+	require.NoError(t, err)
+
+	assert.Equal(t, []float32{0, 4, 10}, row.GetData())
+	assert.Equal(t, [][]float32{{4, 3, 0}, {6, 6, 2}, {0, 4, 10}}, m.GetData())
+}
+
+func mustInvert(t *testing.T, m *Matrix32) *Matrix32 {
+	inv, err := m.Invert()
+	require.NoError(t, err)
+	return inv
+}
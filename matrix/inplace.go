@@ -0,0 +1,244 @@
+package matrix
+
+import (
+	"fmt"
+
+	"github.com/JoLandry/linalgo/vector"
+)
+
+// Tells whether the storage of the two matrices has at least one element in common.
+//
+// Matrices only share storage through views (see Slice), which all have the stride
+// of the matrix they were taken from. Every storage array is allocated by New with
+// exactly nbRows*stride elements, so positions counted from the end of the array
+// give the row and column of an element in the original matrix.
+func (m *MatrixOf[T]) overlaps(other *MatrixOf[T]) bool {
+	if len(m.data) == 0 || len(other.data) == 0 {
+		return false
+	}
+	// Same underlying array if and only if the last element of the array is the same
+	mEnd := m.data[:cap(m.data)]
+	otherEnd := other.data[:cap(other.data)]
+	if &mEnd[len(mEnd)-1] != &otherEnd[len(otherEnd)-1] {
+		return false
+	}
+
+	mStart, otherStart := -cap(m.data), -cap(other.data)
+	if m.stride != other.stride {
+		// Conservative check on the ranges of memory spanned by the matrices
+		return mStart < otherStart+len(other.data) && otherStart < mStart+len(m.data)
+	}
+
+	stride := m.stride
+	mRow, mCol := floorDivMod(mStart, stride)
+	otherRow, otherCol := floorDivMod(otherStart, stride)
+
+	return mRow < otherRow+other.nbRows && otherRow < mRow+m.nbRows &&
+		mCol < otherCol+other.nbCols && otherCol < mCol+m.nbCols
+}
+
+// Tells whether the two matrices are exactly the same elements of the same storage.
+func (m *MatrixOf[T]) sameStorage(other *MatrixOf[T]) bool {
+	return m.nbRows == other.nbRows && m.nbCols == other.nbCols && m.stride == other.stride &&
+		(len(m.data) == 0 || &m.data[0] == &other.data[0])
+}
+
+// Tells whether the two vectors share their storage.
+//
+// Vectors never partially share their storage: either all elements are shared, or none.
+func sameVector[T vector.Float](v1, v2 *vector.VectorOf[T]) bool {
+	values1, values2 := v1.GetData(), v2.GetData()
+	return len(values1) > 0 && len(values2) > 0 && &values1[0] == &values2[0]
+}
+
+// Returns the quotient and the non-negative remainder of the division of a by b > 0.
+func floorDivMod(a, b int) (int, int) {
+	q, r := a/b, a%b
+	if r < 0 {
+		q--
+		r += b
+	}
+
+	return q, r
+}
+
+// Checks that a and b have the same dimensions, that dst can hold the result
+// of an element-wise operation between them, and that dst does not partially
+// overlap one of them.
+//
+// The operation is used to build the error message.
+func checkElementWise[T vector.Float](dst, a, b *MatrixOf[T], operation string) error {
+	if a.nbRows != b.nbRows || a.nbCols != b.nbCols {
+		return fmt.Errorf("mismatch in the number of rows or columns between matrices, cannot perform %s", operation)
+	}
+	if dst.nbRows != a.nbRows || dst.nbCols != a.nbCols {
+		return fmt.Errorf("destination of size %dx%d cannot hold the result of size %dx%d, cannot perform %s", dst.nbRows, dst.nbCols, a.nbRows, a.nbCols, operation)
+	}
+	for _, operand := range []*MatrixOf[T]{a, b} {
+		if dst.overlaps(operand) && !dst.sameStorage(operand) {
+			return fmt.Errorf("destination partially overlaps an operand, cannot perform %s", operation)
+		}
+	}
+
+	return nil
+}
+
+// Stores the element-wise sum of a and b into dst, without allocating.
+//
+// The destination may be a or b itself, but must not partially overlap them.
+// Returns an error if the dimensions do not agree or if dst partially overlaps an operand.
+func AddTo[T vector.Float](dst, a, b *MatrixOf[T]) error {
+	if err := checkElementWise(dst, a, b, "addition"); err != nil {
+		return err
+	}
+	for i := 0; i < dst.nbRows; i++ {
+		dstRow, aRow, bRow := dst.row(i), a.row(i), b.row(i)
+		for j := range dstRow {
+			dstRow[j] = aRow[j] + bRow[j]
+		}
+	}
+
+	return nil
+}
+
+// Stores the element-wise difference a - b into dst, without allocating.
+//
+// The destination may be a or b itself, but must not partially overlap them.
+// Returns an error if the dimensions do not agree or if dst partially overlaps an operand.
+func SubTo[T vector.Float](dst, a, b *MatrixOf[T]) error {
+	if err := checkElementWise(dst, a, b, "substraction"); err != nil {
+		return err
+	}
+	for i := 0; i < dst.nbRows; i++ {
+		dstRow, aRow, bRow := dst.row(i), a.row(i), b.row(i)
+		for j := range dstRow {
+			dstRow[j] = aRow[j] - bRow[j]
+		}
+	}
+
+	return nil
+}
+
+// Stores the element-wise (Hadamard) product of a and b into dst, without allocating.
+//
+// The destination may be a or b itself, but must not partially overlap them.
+// Returns an error if the dimensions do not agree or if dst partially overlaps an operand.
+func HadamardTo[T vector.Float](dst, a, b *MatrixOf[T]) error {
+	if err := checkElementWise(dst, a, b, "element-wise multiplication"); err != nil {
+		return err
+	}
+	for i := 0; i < dst.nbRows; i++ {
+		dstRow, aRow, bRow := dst.row(i), a.row(i), b.row(i)
+		for j := range dstRow {
+			dstRow[j] = aRow[j] * bRow[j]
+		}
+	}
+
+	return nil
+}
+
+// Stores the element-wise quotient a / b into dst, without allocating.
+//
+// Division by zero follows the IEEE 754 rules, giving infinite or NaN elements.
+// The destination may be a or b itself, but must not partially overlap them.
+// Returns an error if the dimensions do not agree or if dst partially overlaps an operand.
+func HadamardDivTo[T vector.Float](dst, a, b *MatrixOf[T]) error {
+	if err := checkElementWise(dst, a, b, "element-wise division"); err != nil {
+		return err
+	}
+	for i := 0; i < dst.nbRows; i++ {
+		dstRow, aRow, bRow := dst.row(i), a.row(i), b.row(i)
+		for j := range dstRow {
+			dstRow[j] = aRow[j] / bRow[j]
+		}
+	}
+
+	return nil
+}
+
+// Stores a * scalar into dst, without allocating.
+//
+// The destination may be a itself, but must not partially overlap it.
+// Returns an error if the dimensions do not agree or if dst partially overlaps a.
+func MulScalarTo[T vector.Float](dst, a *MatrixOf[T], scalar T) error {
+	if err := checkElementWise(dst, a, a, "scalar multiplication"); err != nil {
+		return err
+	}
+	for i := 0; i < dst.nbRows; i++ {
+		dstRow, aRow := dst.row(i), a.row(i)
+		for j := range dstRow {
+			dstRow[j] = aRow[j] * scalar
+		}
+	}
+
+	return nil
+}
+
+// Stores the matrix product a * b into dst, reusing its storage.
+//
+// Since every element of the product depends on a whole row of a and a whole
+// column of b, dst must not share any element with a or b.
+// Returns an error if the dimensions do not agree or if dst overlaps an operand.
+func MulTo[T vector.Float](dst, a, b *MatrixOf[T]) error {
+	if a.nbCols != b.nbRows {
+		return fmt.Errorf("mismatch between number of columns of first matrix (%d) and number of rows of second matrix (%d), cannot perform multiplication", a.nbCols, b.nbRows)
+	}
+	if dst.nbRows != a.nbRows || dst.nbCols != b.nbCols {
+		return fmt.Errorf("destination of size %dx%d cannot hold the result of size %dx%d, cannot perform multiplication", dst.nbRows, dst.nbCols, a.nbRows, b.nbCols)
+	}
+	if dst.overlaps(a) || dst.overlaps(b) {
+		return fmt.Errorf("destination overlaps an operand, cannot perform multiplication")
+	}
+
+	for i := 0; i < dst.nbRows; i++ {
+		clear(dst.row(i))
+	}
+	mulInto(dst, a, b)
+
+	return nil
+}
+
+// Stores the matrix-vector product a * v into dst, without allocating.
+//
+// The destination must not be v itself.
+// Returns an error if the dimensions do not agree or if dst is v.
+func MulVecTo[T vector.Float](dst *vector.VectorOf[T], a *MatrixOf[T], v *vector.VectorOf[T]) error {
+	if err := checkApply(v, dst, a.nbRows, a.nbCols); err != nil {
+		return err
+	}
+
+	values, dstValues := v.GetData(), dst.GetData()
+	for i := range dstValues {
+		var value T
+		for j, val := range a.row(i) {
+			value += val * values[j]
+		}
+		dstValues[i] = value
+	}
+
+	return nil
+}
+
+// Adds other to the calling matrix, in place.
+//
+// Returns an error if the dimensions differ or if other partially overlaps the matrix.
+func (m *MatrixOf[T]) AddInPlace(other *MatrixOf[T]) error {
+	return AddTo(m, m, other)
+}
+
+// Subtracts other from the calling matrix, in place.
+//
+// Returns an error if the dimensions differ or if other partially overlaps the matrix.
+func (m *MatrixOf[T]) SubInPlace(other *MatrixOf[T]) error {
+	return SubTo(m, m, other)
+}
+
+// Multiplies each element of the calling matrix by the given scalar value, in place.
+func (m *MatrixOf[T]) MulScalarInPlace(scalar T) {
+	for i := 0; i < m.nbRows; i++ {
+		mRow := m.row(i)
+		for j := range mRow {
+			mRow[j] *= scalar
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package matrix
+
+import (
+	"testing"
+
+	"github.com/JoLandry/linalgo/vector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddToAndSubTo(t *testing.T) {
+	a, _ := NewFromData([][]float64{{1, 2}, {3, 4}})
+	b, _ := NewFromData([][]float64{{5, 6}, {7, 8}})
+	dst := New(2, 2)
+
+	require.NoError(t, AddTo(dst, a, b))
+	assert.Equal(t, [][]float64{{6, 8}, {10, 12}}, dst.GetData())
+	require.NoError(t, SubTo(dst, a, b))
+	assert.Equal(t, [][]float64{{-4, -4}, {-4, -4}}, dst.GetData())
+}
+
+func TestHadamardToAndHadamardDivTo(t *testing.T) {
+	a, _ := NewFromData([][]float64{{1, 2}, {3, 4}})
+	b, _ := NewFromData([][]float64{{5, 4}, {2, 8}})
+	dst := New(2, 2)
+
+	require.NoError(t, HadamardTo(dst, a, b))
+	assert.Equal(t, [][]float64{{5, 8}, {6, 32}}, dst.GetData())
+	require.NoError(t, HadamardDivTo(a, a, b))
+	assert.Equal(t, [][]float64{{0.2, 0.5}, {1.5, 0.5}}, a.GetData())
+	assert.ErrorContains(t, HadamardTo(dst, a, New(1, 2)), "element-wise multiplication")
+	assert.ErrorContains(t, HadamardDivTo(dst, a, New(1, 2)), "element-wise division")
+}
+
+func TestElementWiseTo_ShouldFail_DimensionMismatch(t *testing.T) {
+	errOperands := AddTo(New(2, 2), New(2, 2), New(2, 3))
+	errDestination := SubTo(New(3, 2), New(2, 2), New(2, 2))
+
+	assert.Error(t, errOperands)
+	assert.Contains(t, errOperands.Error(), "cannot perform addition")
+	assert.Error(t, errDestination)
+	assert.Contains(t, errDestination.Error(), "destination of size 3x2")
+}
+
+func TestElementWiseTo_DestinationIsOperand(t *testing.T) {
+	m := newSequenceMatrix(3, 3)
+	view, _ := m.Slice(1, 3, 1, 3)
+	sameView, _ := m.Slice(1, 3, 1, 3)
+
+	require.NoError(t, AddTo(view, sameView, NewIdentity(2)))
+
+	assert.Equal(t, [][]float64{{1, 2, 3}, {4, 6, 6}, {7, 8, 10}}, m.GetData())
+}
+
+func TestElementWiseTo_ShouldFail_PartialOverlap(t *testing.T) {
+	m := newSequenceMatrix(3, 3)
+	top, _ := m.Slice(0, 2, 0, 2)
+	shifted, _ := m.Slice(1, 3, 1, 3)
+
+	err := AddTo(top, shifted, New(2, 2))
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "overlap")
+}
+
+func TestElementWiseTo_DisjointViewsOfSameMatrix(t *testing.T) {
+	m := newSequenceMatrix(2, 4)
+	left, _ := m.Slice(0, 2, 0, 2)
+	right, _ := m.Slice(0, 2, 2, 4)
+
+	require.NoError(t, AddTo(left, right, right))
+
+	assert.Equal(t, [][]float64{{6, 8, 3, 4}, {14, 16, 7, 8}}, m.GetData())
+}
+
+func TestMulScalarTo(t *testing.T) {
+	a, _ := NewFromData([][]float64{{1, -2}})
+
+	require.NoError(t, MulScalarTo(a, a, 3))
+
+	assert.Equal(t, [][]float64{{3, -6}}, a.GetData())
+	assert.Error(t, MulScalarTo(New(2, 1), a, 3))
+}
+
+func TestMulTo(t *testing.T) {
+	a, _ := NewFromData([][]float64{{1, 2}, {3, 4}})
+	b, _ := NewFromData([][]float64{{0, 1}, {1, 0}})
+	dst := NewFromFlat(2, 2, []float64{9, 9, 9, 9})
+
+	require.NoError(t, MulTo(dst, a, b))
+
+	assert.Equal(t, [][]float64{{2, 1}, {4, 3}}, dst.GetData())
+}
+
+func TestMulTo_ShouldFail(t *testing.T) {
+	a := NewIdentity(2)
+	m := New(2, 4)
+	left, _ := m.Slice(0, 2, 0, 2)
+	right, _ := m.Slice(0, 2, 2, 4)
+
+	errAliased := MulTo(a, a, NewIdentity(2))
+	errOperands := MulTo(New(2, 2), a, New(3, 2))
+	errDestination := MulTo(New(3, 3), a, a)
+
+	assert.Contains(t, errAliased.Error(), "overlaps")
+	assert.Contains(t, errOperands.Error(), "mismatch")
+	assert.Contains(t, errDestination.Error(), "destination of size 3x3")
+	// Disjoint views of the same matrix can be used
+	assert.NoError(t, MulTo(right, left, a))
+}
+
+func TestMulVecTo(t *testing.T) {
+	a, _ := NewFromData([][]float64{{1, 2}, {3, 4}, {5, 6}})
+	v := vector.NewFromData([]float64{1, -1})
+	dst := vector.New(3)
+
+	require.NoError(t, MulVecTo(dst, a, v))
+	assert.Equal(t, []float64{-1, -1, -1}, dst.GetData())
+
+	square := NewIdentity(2)
+	assert.Error(t, MulVecTo(v, square, v))
+	assert.Error(t, MulVecTo(vector.New(2), a, v))
+	assert.Error(t, MulVecTo(dst, a, vector.New(3)))
+}
+
+func TestInPlaceOperations(t *testing.T) {
+	m, _ := NewFromData([][]float64{{1, 2}, {3, 4}})
+
+	require.NoError(t, m.AddInPlace(NewIdentity(2)))
+	assert.Equal(t, [][]float64{{2, 2}, {3, 5}}, m.GetData())
+	require.NoError(t, m.SubInPlace(NewIdentity(2)))
+	assert.Equal(t, [][]float64{{1, 2}, {3, 4}}, m.GetData())
+	m.MulScalarInPlace(2)
+	assert.Equal(t, [][]float64{{2, 4}, {6, 8}}, m.GetData())
+	assert.Error(t, m.AddInPlace(New(1, 2)))
+}
+
+func TestDestinationOperations_DoNotAllocate(t *testing.T) {
+	a := newSequenceMatrix(4, 4)
+	b := NewIdentity(4)
+	dst := New(4, 4)
+	v := vector.NewFromData([]float64{1, 2, 3, 4})
+	w := vector.New(4)
+
+	allocs := testing.AllocsPerRun(10, func() {
+		_ = MulTo(dst, a, b)
+		_ = dst.AddInPlace(a)
+		_ = MulVecTo(w, a, v)
+	})
+
+	assert.Equal(t, 0.0, allocs)
+}
@@ -0,0 +1,289 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/JoLandry/linalgo/vector"
+)
+
+// Threshold under which a pivot is considered to be zero, for float64 elements.
+// It is scaled with vector.Tolerance for other element types.
+const pivotTolerance = 1e-10
+
+// LU holds the LU factorization with partial pivoting of a square matrix.
+//
+// The factorization satisfies P * A = L * U, where P is a permutation matrix,
+// L is unit lower triangular and U is upper triangular.
+// Once computed, it can be reused to get the determinant, the inverse or
+// to solve systems for as many right-hand sides as needed.
+type LU struct {
+	// L (strictly below the diagonal, unit diagonal implied) and U packed together
+	lu *Matrix
+	// pivot[i] is the row of A that ended up at row i
+	pivot []int
+	// Sign of the permutation (+1 or -1)
+	sign float64
+	// Threshold under which a pivot is considered to be zero
+	tolerance float64
+	// 1-norm of A, used by Cond1Estimate
+	norm1 float64
+	// Machine epsilon of the elements of A, used with norm1 to tell negligible pivots
+	epsilon float64
+}
+
+// Computes and returns the LU factorization with partial pivoting of the matrix.
+//
+// At each step, the row holding the largest absolute value in the current
+// column is chosen as the pivot row. Singular matrices are factorized as well,
+// use IsSingular on the result to check them.
+//
+// The factorization is computed in float64, and the pivots are compared with a
+// threshold scaled to the precision of T.
+// Returns an error if the matrix is not square.
+// The original matrix is not modified.
+func (m *MatrixOf[T]) LU() (*LU, error) {
+	if !m.IsSquare() {
+		return nil, fmt.Errorf("cannot compute the LU factorization of a non-square matrix")
+	}
+
+	n := m.nbRows
+	lu := float64Of(m).Copy()
+	pivot := make([]int, n)
+	for i := range pivot {
+		pivot[i] = i
+	}
+	sign := 1.0
+
+	for k := 0; k < n; k++ {
+		// Find pivot element (max absolute value in column k at or below row k)
+		p := k
+		maxVal := math.Abs(lu.row(k)[k])
+		for r := k + 1; r < n; r++ {
+			if math.Abs(lu.row(r)[k]) > maxVal {
+				maxVal = math.Abs(lu.row(r)[k])
+				p = r
+			}
+		}
+		if p != k {
+			lu.swapRows(k, p)
+			pivot[k], pivot[p] = pivot[p], pivot[k]
+			sign = -sign
+		}
+		// Nothing to eliminate in this column
+		if lu.row(k)[k] == 0.0 {
+			continue
+		}
+		// Eliminate below, storing the multipliers in place
+		pivotRow := lu.row(k)
+		for r := k + 1; r < n; r++ {
+			values := lu.row(r)
+			factor := values[k] / pivotRow[k]
+			values[k] = factor
+			if factor == 0.0 {
+				continue
+			}
+			for c := k + 1; c < n; c++ {
+				values[c] -= factor * pivotRow[c]
+			}
+		}
+	}
+
+	return &LU{
+		lu:        lu,
+		pivot:     pivot,
+		sign:      sign,
+		tolerance: vector.Tolerance[T](pivotTolerance),
+		norm1:     m.transposedNormInf(),
+		epsilon:   vector.Epsilon[T](),
+	}, nil
+}
+
+// Returns the unit lower triangular factor L.
+func (f *LU) L() *Matrix {
+	n := f.lu.nbRows
+	l := New(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			l.row(i)[j] = f.lu.row(i)[j]
+		}
+		l.row(i)[i] = 1.0
+	}
+
+	return l
+}
+
+// Returns the upper triangular factor U.
+func (f *LU) U() *Matrix {
+	n := f.lu.nbRows
+	u := New(n, n)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			u.row(i)[j] = f.lu.row(i)[j]
+		}
+	}
+
+	return u
+}
+
+// Returns the permutation matrix P such that P * A = L * U.
+func (f *LU) P() *Matrix {
+	n := f.lu.nbRows
+	p := New(n, n)
+	for i, row := range f.pivot {
+		p.row(i)[row] = 1.0
+	}
+
+	return p
+}
+
+// Returns the row permutation of the factorization.
+//
+// Element i is the index of the row of the original matrix that
+// was moved to row i. The returned slice is a copy.
+func (f *LU) Pivot() []int {
+	pivot := make([]int, len(f.pivot))
+	copy(pivot, f.pivot)
+
+	return pivot
+}
+
+// Tells whether the factorized matrix is singular, namely
+// whether one of the pivots is (numerically) zero.
+//
+// By convention, an empty (0x0) matrix is considered singular.
+func (f *LU) IsSingular() bool {
+	n := f.lu.nbRows
+	if n == 0 {
+		return true
+	}
+	for i := 0; i < n; i++ {
+		if math.Abs(f.lu.row(i)[i]) < f.tolerance {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Tells whether the factorized matrix is singular compared to its scale, namely
+// whether one of the pivots satisfies |U[k][k]| <= n * eps * ||A||_1, where eps
+// is the machine epsilon of the elements of A.
+//
+// Unlike IsSingular, whose threshold is absolute, the answer does not change
+// when A is multiplied by a scalar: 1e-11 * I is not singular.
+// By convention, an empty (0x0) matrix is considered singular.
+func (f *LU) isRelativelySingular() bool {
+	n := f.lu.nbRows
+	if n == 0 {
+		return true
+	}
+	threshold := float64(n) * f.epsilon * f.norm1
+	for i := 0; i < n; i++ {
+		if math.Abs(f.lu.row(i)[i]) <= threshold {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns the determinant of the factorized matrix, computed as the
+// product of the diagonal of U times the sign of the permutation.
+//
+// By convention, the determinant of an empty (0x0) matrix is zero.
+func (f *LU) Determinant() float64 {
+	n := f.lu.nbRows
+	if n == 0 {
+		return 0.0
+	}
+	determinant := f.sign
+	for i := 0; i < n; i++ {
+		determinant *= f.lu.row(i)[i]
+	}
+
+	return determinant
+}
+
+// Solves A * X = B for X, where A is the factorized matrix and each
+// column of B is a right-hand side.
+//
+// Returns an error if B does not have as many rows as A,
+// or an error wrapping ErrSingular if A is singular.
+func (f *LU) SolveMatrix(b *Matrix) (*Matrix, error) {
+	n := f.lu.nbRows
+	if b.nbRows != n {
+		return nil, fmt.Errorf("mismatch between size of factorized matrix (%d) and number of rows of right-hand side (%d)", n, b.nbRows)
+	}
+	if f.IsSingular() {
+		return nil, fmt.Errorf("%w, cannot solve", ErrSingular)
+	}
+
+	return f.solve(b), nil
+}
+
+// Returns the solution X of A * X = B, without checking whether A is singular.
+//
+// This helper assumes B has as many rows as A, and that the pivots are not zero.
+// Callers check for singularity beforehand, for instance with isRelativelySingular.
+func (f *LU) solve(b *Matrix) *Matrix {
+	// Apply the permutation
+	x := New(f.lu.nbRows, b.nbCols)
+	for i, row := range f.pivot {
+		copy(x.row(i), b.row(row))
+	}
+	for j := 0; j < b.nbCols; j++ {
+		f.solveInPlace(x, j)
+	}
+
+	return x
+}
+
+// Returns the inverse of the factorized matrix.
+//
+// Returns an error wrapping ErrSingular if the matrix is singular.
+func (f *LU) Inverse() (*Matrix, error) {
+	inv, err := f.SolveMatrix(NewIdentity(f.lu.nbRows))
+	if err != nil {
+		return nil, fmt.Errorf("%w, cannot invert", ErrSingular)
+	}
+
+	return inv, nil
+}
+
+// Returns the inverse of the factorized matrix, like Inverse, but deciding
+// singularity relative to the scale of the matrix (see isRelativelySingular),
+// so that matrices with tiny but well-conditioned entries can be inverted.
+//
+// Returns an error wrapping ErrSingular if the matrix is singular compared to its scale.
+func (f *LU) scaledInverse() (*Matrix, error) {
+	if f.isRelativelySingular() {
+		return nil, fmt.Errorf("%w, cannot invert", ErrSingular)
+	}
+
+	return f.solve(NewIdentity(f.lu.nbRows)), nil
+}
+
+// Runs forward then back substitution on column col of x, which must
+// already hold the permuted right-hand side.
+//
+// This helper assumes the factorization is not singular.
+func (f *LU) solveInPlace(x *Matrix, col int) {
+	n := f.lu.nbRows
+	// Forward substitution with L (unit diagonal)
+	for i := 0; i < n; i++ {
+		sum := x.row(i)[col]
+		for k := 0; k < i; k++ {
+			sum -= f.lu.row(i)[k] * x.row(k)[col]
+		}
+		x.row(i)[col] = sum
+	}
+	// Back substitution with U
+	for i := n - 1; i >= 0; i-- {
+		sum := x.row(i)[col]
+		for k := i + 1; k < n; k++ {
+			sum -= f.lu.row(i)[k] * x.row(k)[col]
+		}
+		x.row(i)[col] = sum / f.lu.row(i)[i]
+	}
+}
@@ -0,0 +1,155 @@
+package matrix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLU_ShouldFail_NonSquareMatrix(t *testing.T) {
+	m := New(2, 3)
+
+	lu, err := m.LU()
+
+	assert.Nil(t, lu)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "non-square")
+}
+
+func TestLU_FactorsReconstructPermutedMatrix(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 10},
+	})
+
+	lu, err := m.LU()
+	require.NoError(t, err)
+
+	pa, err := lu.P().Mul(m)
+	require.NoError(t, err)
+	product, err := lu.L().Mul(lu.U())
+	require.NoError(t, err)
+
+	assert.True(t, pa.EqualsApprox(product, 1e-9), "expected %v, got %v", pa, product)
+	assert.Equal(t, []int{2, 0, 1}, lu.Pivot())
+}
+
+func TestLU_FactorsHaveTriangularShape(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{2, -1, 0},
+		{-1, 2, -1},
+		{0, -1, 2},
+	})
+
+	lu, err := m.LU()
+	require.NoError(t, err)
+
+	l := lu.L()
+	u := lu.U()
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, 1.0, l.GetElementAt(i, i))
+		for j := i + 1; j < 3; j++ {
+			assert.Equal(t, 0.0, l.GetElementAt(i, j))
+			assert.Equal(t, 0.0, u.GetElementAt(j, i))
+		}
+	}
+}
+
+func TestLU_Determinant(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{0, 2, 1},
+		{1, 1, 1},
+		{2, 1, 3},
+	})
+
+	lu, err := m.LU()
+	require.NoError(t, err)
+
+	assert.InDelta(t, -3.0, lu.Determinant(), 1e-9)
+}
+
+func TestLU_IsSingular(t *testing.T) {
+	singular, _ := NewFromData([][]float64{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	})
+	regular := NewIdentity(3)
+
+	luSingular, err := singular.LU()
+	require.NoError(t, err)
+	luRegular, err := regular.LU()
+	require.NoError(t, err)
+
+	assert.True(t, luSingular.IsSingular())
+	assert.False(t, luRegular.IsSingular())
+}
+
+func TestLU_SolveMatrix_MultipleRightHandSides(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{4, 3},
+		{6, 3},
+	})
+	b, _ := NewFromData([][]float64{
+		{10, 7},
+		{12, 9},
+	})
+
+	lu, err := m.LU()
+	require.NoError(t, err)
+	x, err := lu.SolveMatrix(b)
+	require.NoError(t, err)
+
+	product, _ := m.Mul(x)
+	assert.True(t, product.EqualsApprox(b, 1e-9), "expected %v, got %v", b, product)
+}
+
+func TestLU_SolveMatrix_ShouldFail_DimensionMismatch(t *testing.T) {
+	lu, err := NewIdentity(2).LU()
+	require.NoError(t, err)
+
+	_, err = lu.SolveMatrix(New(3, 1))
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "mismatch")
+}
+
+func TestLU_SolveMatrix_ShouldFail_Singular(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{1, 2},
+		{2, 4},
+	})
+	lu, err := m.LU()
+	require.NoError(t, err)
+
+	_, err = lu.SolveMatrix(New(2, 1))
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "singular")
+}
+
+func TestLU_Inverse_LargeMatrix(t *testing.T) {
+	// Tridiagonal matrix way too large for a Laplace expansion
+	n := 40
+	m := New(n, n)
+	for i := 0; i < n; i++ {
+		m.SetElementAt(i, i, 4)
+		if i > 0 {
+			m.SetElementAt(i, i-1, -1)
+			m.SetElementAt(i-1, i, -1)
+		}
+	}
+
+	inv, err := m.Invert()
+	require.NoError(t, err)
+
+	product, _ := m.Mul(inv)
+	assert.True(t, product.EqualsApprox(NewIdentity(n), 1e-9))
+
+	det, err := m.Determinant()
+	require.NoError(t, err)
+	assert.Greater(t, det, 0.0)
+	assert.True(t, m.IsInvertible())
+}
@@ -1,26 +1,47 @@
 // Package matrix provides basic matrix operations including creation,
 // access, arithmetic, and linear algebra utilities such as determinant,
 // rank, transpose, inversion and others.
+//
+// Matrices hold float64 (Matrix) or float32 (Matrix32) elements, both being
+// instances of the generic MatrixOf type.
 package matrix
 
 import (
 	"fmt"
 	"math"
 	"strings"
+
+	"github.com/JoLandry/linalgo/vector"
 )
 
-// Matrix represents a two-dimensional matrix of float64 values.
+// MatrixOf represents a two-dimensional matrix of values of type T.
 //
+// Elements are stored contiguously in row-major order: element (i, j) is
+// located at index i*stride + j of data. The stride is the distance between
+// two consecutive rows, which is equal to the number of columns unless the
+// matrix shares its storage with a larger one.
 // It includes metadata for the number of rows and columns to simplify
 // operations and validations.
-type Matrix struct {
-	data   [][]float64
+//
+// Element-wise operations and products are computed with elements of type T.
+// Factorizations (LU, QR, SVD, eigen-decompositions, ...) are computed in float64,
+// with tolerances scaled to the precision of T, and results of type T are rounded
+// from them.
+type MatrixOf[T vector.Float] struct {
+	data   []T
+	stride int
 	nbRows int
 	nbCols int
 }
 
+// Matrix represents a two-dimensional matrix of float64 values.
+type Matrix = MatrixOf[float64]
+
+// Matrix32 represents a two-dimensional matrix of float32 values.
+type Matrix32 = MatrixOf[float32]
+
 // String returns a human-readable string representation of the matrix.
-func (m *Matrix) String() string {
+func (m *MatrixOf[T]) String() string {
 	if m.nbRows == 0 || m.nbCols == 0 {
 		return "[]"
 	}
@@ -30,7 +51,7 @@ func (m *Matrix) String() string {
 	for i := 0; i < m.nbRows; i++ {
 		builder.WriteString("  [")
 		for j := 0; j < m.nbCols; j++ {
-			builder.WriteString(fmt.Sprintf("%8.4f", m.data[i][j]))
+			builder.WriteString(fmt.Sprintf("%8.4f", m.row(i)[j]))
 			if j < m.nbCols-1 {
 				builder.WriteString(", ")
 			}
@@ -45,152 +66,244 @@ func (m *Matrix) String() string {
 	return builder.String()
 }
 
-// Get the data of the matrix
-func (m *Matrix) GetData() [][]float64 {
-	return m.data
+// Get the data of the matrix, as a 2D slice (deep copy)
+func (m *MatrixOf[T]) GetData() [][]T {
+	data := make([][]T, m.nbRows)
+	for i := range data {
+		data[i] = make([]T, m.nbCols)
+		copy(data[i], m.row(i))
+	}
+	return data
 }
 
 // Get the numbers of Rows of the matrix
-func (m *Matrix) GetNbRows() int {
+func (m *MatrixOf[T]) GetNbRows() int {
 	return m.nbRows
 }
 
 // Get the number of columns of the matrix
-func (m *Matrix) GetNbCols() int {
+func (m *MatrixOf[T]) GetNbCols() int {
 	return m.nbCols
 }
 
 // Returns true if the matrix is a square matrix, false otherwise
-func (m *Matrix) IsSquare() bool {
+func (m *MatrixOf[T]) IsSquare() bool {
 	return m.nbCols == m.nbRows
 }
 
 // Get the element at index idx
-func (m *Matrix) GetElementAt(row int, col int) float64 {
-	return m.data[row][col]
+//
+// It panics if the indices are out of range.
+func (m *MatrixOf[T]) GetElementAt(row int, col int) T {
+	m.checkIndices(row, col)
+	return m.data[row*m.stride+col]
 }
 
 // Set the element called elt at indices (row,col)
-func (m *Matrix) SetElementAt(row int, col int, elt float64) {
-	m.data[row][col] = elt
+//
+// It panics if the indices are out of range.
+func (m *MatrixOf[T]) SetElementAt(row int, col int, elt T) {
+	m.checkIndices(row, col)
+	m.data[row*m.stride+col] = elt
+}
+
+// Panics if (row, col) is not a position of the matrix.
+//
+// The flat storage would otherwise silently map it to another element,
+// possibly outside of a view.
+func (m *MatrixOf[T]) checkIndices(row int, col int) {
+	if row < 0 || row >= m.nbRows || col < 0 || col >= m.nbCols {
+		panic(fmt.Sprintf("index (%d, %d) out of range for a %dx%d matrix", row, col, m.nbRows, m.nbCols))
+	}
+}
+
+// Returns the elements of row i, sharing the storage of the matrix.
+func (m *MatrixOf[T]) row(i int) []T {
+	start := i * m.stride
+	return m.data[start : start+m.nbCols : start+m.nbCols]
+}
+
+// Swaps rows i and j of the matrix in place.
+func (m *MatrixOf[T]) swapRows(i int, j int) {
+	if i == j {
+		return
+	}
+	rowI := m.row(i)
+	rowJ := m.row(j)
+	for k := range rowI {
+		rowI[k], rowJ[k] = rowJ[k], rowI[k]
+	}
+}
+
+// Returns a deep copy of the matrix, with contiguous storage.
+func (m *MatrixOf[T]) Copy() *MatrixOf[T] {
+	result := NewOf[T](m.nbRows, m.nbCols)
+	if m.stride == m.nbCols {
+		copy(result.data, m.data[:m.nbRows*m.nbCols])
+		return result
+	}
+	for i := 0; i < m.nbRows; i++ {
+		copy(result.row(i), m.row(i))
+	}
+	return result
 }
 
 // Create a new matrix from a given number of rows and a given number of columns
 func New(nbRowsMat int, nbColsMat int) *Matrix {
-	data := make([][]float64, nbRowsMat)
-	for i := range data {
-		data[i] = make([]float64, nbColsMat)
-	}
-	return &Matrix{
-		data:   data,
+	return NewOf[float64](nbRowsMat, nbColsMat)
+}
+
+// Create a new matrix from a given 2D slice (deep copy)
+//
+// If the input has no row, then a pointer to a new empty matrix
+// Is returned
+//
+// Return an error if there is any inconsistency in the number of columns
+func NewFromData(mData [][]float64) (*Matrix, error) {
+	return NewFromDataOf(mData)
+}
+
+// Creates and returns a new identity matrix of a given size.
+func NewIdentity(size int) *Matrix {
+	return NewIdentityOf[float64](size)
+}
+
+// Creates and returns a matrix from a flat slice of values.
+//
+// The matrix will have the specified number of rows and columns.
+// If the number of values is not exactly rows * cols, it panics.
+func NewFromFlat(rows, cols int, values []float64) *Matrix {
+	return NewFromFlatOf(rows, cols, values)
+}
+
+// Creates and returns a square diagonal matrix with the provided diagonal values.
+//
+// All non-diagonal elements are set to zero.
+func NewDiagonal(values []float64) *Matrix {
+	return NewDiagonalOf(values)
+}
+
+// Create a new matrix of elements of type T from a given number of rows
+// and a given number of columns
+func NewOf[T vector.Float](nbRowsMat int, nbColsMat int) *MatrixOf[T] {
+	return &MatrixOf[T]{
+		data:   make([]T, nbRowsMat*nbColsMat),
+		stride: nbColsMat,
 		nbRows: nbRowsMat,
 		nbCols: nbColsMat,
 	}
 }
 
-// Create a new matrix from a given 2D slice (deep copy)
+// Create a new matrix of elements of type T from a given 2D slice (deep copy)
 //
 // If the input has no row, then a pointer to a new empty matrix
 // Is returned
 //
 // Return an error if there is any inconsistency in the number of columns
-func NewFromData(mData [][]float64) (*Matrix, error) {
+func NewFromDataOf[T vector.Float](mData [][]T) (*MatrixOf[T], error) {
 	nbRows := len(mData)
 	if nbRows == 0 {
-		return &Matrix{
-			data:   [][]float64{},
-			nbRows: 0,
-			nbCols: 0,
-		}, nil
+		return NewOf[T](0, 0), nil
 	}
 
 	nbCols := len(mData[0])
 
 	// Validate & deep copy
-	copiedMatrix := make([][]float64, nbRows)
+	result := NewOf[T](nbRows, nbCols)
 	for i := range mData {
 		if len(mData[i]) != nbCols {
 			return nil, fmt.Errorf("inconsistent number of columns in row %d: expected %d, got %d", i, nbCols, len(mData[i]))
 		}
-		copiedMatrix[i] = make([]float64, nbCols)
-		copy(copiedMatrix[i], mData[i])
+		copy(result.row(i), mData[i])
 	}
 
-	return &Matrix{
-		data:   copiedMatrix,
-		nbRows: nbRows,
-		nbCols: nbCols,
-	}, nil
+	return result, nil
 }
 
-// Creates and returns a new identity matrix of a given size.
-func NewIdentity(size int) *Matrix {
-	data := make([][]float64, size)
-	for i := range data {
-		data[i] = make([]float64, size)
-	}
-	idMatrix := &Matrix{
-		data:   data,
-		nbRows: size,
-		nbCols: size,
-	}
-
+// Creates and returns a new identity matrix of elements of type T of a given size.
+func NewIdentityOf[T vector.Float](size int) *MatrixOf[T] {
+	idMatrix := NewOf[T](size, size)
 	for k := 0; k < size; k++ {
-		data[k][k] = 1.0
+		idMatrix.data[k*size+k] = 1.0
 	}
 
 	return idMatrix
 }
 
-// Creates and returns a matrix from a flat slice of values.
+// Creates and returns a matrix of elements of type T from a flat slice of values.
 //
 // The matrix will have the specified number of rows and columns.
 // If the number of values is not exactly rows * cols, it panics.
-func NewFromFlat(rows, cols int, values []float64) *Matrix {
+func NewFromFlatOf[T vector.Float](rows, cols int, values []T) *MatrixOf[T] {
 	if len(values) != rows*cols {
 		panic("number of values does not match matrix dimensions")
 	}
 
-	data := make([][]float64, rows)
-	for i := 0; i < rows; i++ {
-		data[i] = make([]float64, cols)
-		for j := 0; j < cols; j++ {
-			data[i][j] = values[i*cols+j]
-		}
-	}
+	result := NewOf[T](rows, cols)
+	copy(result.data, values)
 
-	return &Matrix{
-		data:   data,
-		nbRows: rows,
-		nbCols: cols,
-	}
+	return result
 }
 
-// Creates and returns a square diagonal matrix with the provided diagonal values.
+// Creates and returns a square diagonal matrix of elements of type T
+// with the provided diagonal values.
 //
 // All non-diagonal elements are set to zero.
-func NewDiagonal(values []float64) *Matrix {
+func NewDiagonalOf[T vector.Float](values []T) *MatrixOf[T] {
 	size := len(values)
-	data := make([][]float64, size)
+	result := NewOf[T](size, size)
 	for i := 0; i < size; i++ {
-		data[i] = make([]float64, size)
-		data[i][i] = values[i]
+		result.data[i*size+i] = values[i]
+	}
+
+	return result
+}
+
+// Returns a copy of the matrix m with its elements converted to type U,
+// for instance to go from float32 to float64 elements or conversely.
+func Convert[U, T vector.Float](m *MatrixOf[T]) *MatrixOf[U] {
+	result := NewOf[U](m.nbRows, m.nbCols)
+	for i := 0; i < m.nbRows; i++ {
+		resultRow := result.row(i)
+		for j, val := range m.row(i) {
+			resultRow[j] = U(val)
+		}
 	}
 
-	return &Matrix{
-		data:   data,
-		nbRows: size,
-		nbCols: size,
+	return result
+}
+
+// Returns the matrix m with float64 elements, to compute factorizations.
+//
+// The storage is shared (and must not be modified) if T is float64,
+// otherwise the elements are converted into a new matrix.
+func float64Of[T vector.Float](m *MatrixOf[T]) *Matrix {
+	if result, ok := any(m).(*Matrix); ok {
+		return result
 	}
+
+	return Convert[float64](m)
+}
+
+// Returns the float64 matrix m with elements of type T, rounding them if needed.
+//
+// The storage is shared if T is float64, otherwise the elements are converted
+// into a new matrix.
+func fromFloat64[T vector.Float](m *Matrix) *MatrixOf[T] {
+	if result, ok := any(m).(*MatrixOf[T]); ok {
+		return result
+	}
+
+	return Convert[T](m)
 }
 
 // Tells whether all elements of the matrix are zero.
 //
 // Returns true if the matrix is a zero matrix, false otherwise.
-func (m *Matrix) IsZero() bool {
+func (m *MatrixOf[T]) IsZero() bool {
 	for i := 0; i < m.nbRows; i++ {
-		for j := 0; j < m.nbCols; j++ {
-			if m.data[i][j] != 0 {
+		for _, val := range m.row(i) {
+			if val != 0 {
 				return false
 			}
 		}
@@ -202,34 +315,48 @@ func (m *Matrix) IsZero() bool {
 // Returns a new matrix that is the element-wise sum of m and other.
 //
 // Returns an error if the matrices do not have the same dimensions.
-func (m *Matrix) Add(other *Matrix) (*Matrix, error) {
-	if m.nbRows != other.nbRows || m.nbCols != other.nbCols {
-		return nil, fmt.Errorf("mismatch in the number of rows or columns between matrices, cannot perform addition")
+func (m *MatrixOf[T]) Add(other *MatrixOf[T]) (*MatrixOf[T], error) {
+	result := NewOf[T](m.nbRows, m.nbCols)
+	if err := AddTo(result, m, other); err != nil {
+		return nil, err
 	}
 
-	result := New(m.nbRows, m.nbCols)
-	for i := 0; i < m.nbRows; i++ {
-		for j := 0; j < m.nbCols; j++ {
-			result.data[i][j] = m.data[i][j] + other.data[i][j]
-		}
+	return result, nil
+}
+
+// Returns a new matrix that is the element-wise difference of m and other.
+//
+// Returns an error if the matrices do not have the same dimensions.
+func (m *MatrixOf[T]) Sub(other *MatrixOf[T]) (*MatrixOf[T], error) {
+	result := NewOf[T](m.nbRows, m.nbCols)
+	if err := SubTo(result, m, other); err != nil {
+		return nil, err
 	}
 
 	return result, nil
 }
 
-// Returns a new matrix that is the element-wise difference of m and other.
+// Returns a new matrix that is the element-wise (Hadamard) product of m and other.
 //
 // Returns an error if the matrices do not have the same dimensions.
-func (m *Matrix) Sub(other *Matrix) (*Matrix, error) {
-	if m.nbRows != other.nbRows || m.nbCols != other.nbCols {
-		return nil, fmt.Errorf("mismatch in the number of rows or columns between matrices, cannot perform substraction")
+func (m *MatrixOf[T]) Hadamard(other *MatrixOf[T]) (*MatrixOf[T], error) {
+	result := NewOf[T](m.nbRows, m.nbCols)
+	if err := HadamardTo(result, m, other); err != nil {
+		return nil, err
 	}
 
-	result := New(m.nbRows, m.nbCols)
-	for i := 0; i < m.nbRows; i++ {
-		for j := 0; j < m.nbCols; j++ {
-			result.data[i][j] = m.data[i][j] - other.data[i][j]
-		}
+	return result, nil
+}
+
+// Returns a new matrix that is the element-wise quotient of m by other.
+//
+// Division by zero follows the IEEE 754 rules, giving infinite or NaN elements.
+// See Div for the multiplication by the inverse of other.
+// Returns an error if the matrices do not have the same dimensions.
+func (m *MatrixOf[T]) HadamardDiv(other *MatrixOf[T]) (*MatrixOf[T], error) {
+	result := NewOf[T](m.nbRows, m.nbCols)
+	if err := HadamardDivTo(result, m, other); err != nil {
+		return nil, err
 	}
 
 	return result, nil
@@ -238,7 +365,7 @@ func (m *Matrix) Sub(other *Matrix) (*Matrix, error) {
 // Tells whether the matrix is a diagonal matrix.
 //
 // By convention, an empty (0x0) matrix is considered diagonal.
-func (m *Matrix) IsDiagonal() bool {
+func (m *MatrixOf[T]) IsDiagonal() bool {
 	if !m.IsSquare() {
 		return false
 	}
@@ -249,7 +376,47 @@ func (m *Matrix) IsDiagonal() bool {
 
 	for i := 0; i < m.nbRows; i++ {
 		for j := 0; j < m.nbCols; j++ {
-			if i != j && m.data[i][j] != 0.0 {
+			if i != j && m.row(i)[j] != 0.0 {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Tells whether the matrix is upper triangular, namely whether all
+// elements below the diagonal are zero.
+//
+// By convention, an empty (0x0) matrix is considered upper triangular.
+func (m *MatrixOf[T]) IsUpperTriangular() bool {
+	if !m.IsSquare() {
+		return false
+	}
+
+	for i := 1; i < m.nbRows; i++ {
+		for _, val := range m.row(i)[:i] {
+			if val != 0.0 {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Tells whether the matrix is lower triangular, namely whether all
+// elements above the diagonal are zero.
+//
+// By convention, an empty (0x0) matrix is considered lower triangular.
+func (m *MatrixOf[T]) IsLowerTriangular() bool {
+	if !m.IsSquare() {
+		return false
+	}
+
+	for i := 0; i < m.nbRows; i++ {
+		for _, val := range m.row(i)[i+1:] {
+			if val != 0.0 {
 				return false
 			}
 		}
@@ -261,7 +428,7 @@ func (m *Matrix) IsDiagonal() bool {
 // Tells whether the matrix is a scalar matrix with the given scalar value.
 //
 // By convention, an empty (0x0) matrix is considered scalar.
-func (m *Matrix) IsScalar(scalar float64) bool {
+func (m *MatrixOf[T]) IsScalar(scalar T) bool {
 	if !m.IsSquare() {
 		return false
 	}
@@ -272,10 +439,10 @@ func (m *Matrix) IsScalar(scalar float64) bool {
 
 	for i := 0; i < m.nbRows; i++ {
 		for j := 0; j < m.nbCols; j++ {
-			if i != j && m.data[i][j] != 0.0 {
+			if i != j && m.row(i)[j] != 0.0 {
 				return false
 			}
-			if i == j && m.data[i][j] != scalar {
+			if i == j && m.row(i)[j] != scalar {
 				return false
 			}
 		}
@@ -287,7 +454,7 @@ func (m *Matrix) IsScalar(scalar float64) bool {
 // Tells whether the matrix is a hollow matrix.
 //
 // By convention, an empty (0x0) matrix is considered hollow.
-func (m *Matrix) IsHollow() bool {
+func (m *MatrixOf[T]) IsHollow() bool {
 	if !m.IsSquare() {
 		return false
 	}
@@ -297,7 +464,7 @@ func (m *Matrix) IsHollow() bool {
 	}
 
 	for i := 0; i < m.nbRows; i++ {
-		if m.data[i][i] != 0.0 {
+		if m.row(i)[i] != 0.0 {
 			return false
 		}
 	}
@@ -308,7 +475,7 @@ func (m *Matrix) IsHollow() bool {
 // Tells whether the matrix is the identity matrix.
 //
 // By convention, an empty (0x0) matrix is considered an identity matrix.
-func (m *Matrix) IsIdentity() bool {
+func (m *MatrixOf[T]) IsIdentity() bool {
 	if !m.IsSquare() {
 		return false
 	}
@@ -319,10 +486,10 @@ func (m *Matrix) IsIdentity() bool {
 
 	for i := 0; i < m.nbRows; i++ {
 		for j := 0; j < m.nbCols; j++ {
-			if i != j && m.data[i][j] != 0.0 {
+			if i != j && m.row(i)[j] != 0.0 {
 				return false
 			}
-			if i == j && m.data[i][j] != 1.0 {
+			if i == j && m.row(i)[j] != 1.0 {
 				return false
 			}
 		}
@@ -337,15 +504,16 @@ func (m *Matrix) IsIdentity() bool {
 // Each element of the matrix is multiplied by the scalar. The original matrix
 // is not modified. If the scalar is 1.0 or the matrix is a zero matrix,
 // the original matrix is returned as-is (no copy is made).
-func (m *Matrix) MulScalar(scalar float64) *Matrix {
+func (m *MatrixOf[T]) MulScalar(scalar T) *MatrixOf[T] {
 	if m.IsZero() || scalar == 1.0 {
 		return m
 	}
 
-	result := New(m.nbRows, m.nbCols)
+	result := NewOf[T](m.nbRows, m.nbCols)
 	for i := 0; i < m.nbRows; i++ {
-		for j := 0; j < m.nbCols; j++ {
-			result.data[i][j] = m.data[i][j] * scalar
+		resultRow, mRow := result.row(i), m.row(i)
+		for j := range resultRow {
+			resultRow[j] = mRow[j] * scalar
 		}
 	}
 	return result
@@ -355,91 +523,46 @@ func (m *Matrix) MulScalar(scalar float64) *Matrix {
 //
 // It returns a new matrix representing the product m * other. If the number of
 // columns in the calling matrix does not match the number of rows in the other matrix,
-// an error is returned. If either matrix is a zero matrix, a new zero matrix of
-// the correct dimensions is returned.
-func (m *Matrix) Mul(other *Matrix) (*Matrix, error) {
+// an error is returned.
+//
+// Large products are computed with a cache-blocked kernel, running on up to
+// MulWorkers() goroutines (see SetMulWorkers). The result does not depend
+// on the number of workers.
+func (m *MatrixOf[T]) Mul(other *MatrixOf[T]) (*MatrixOf[T], error) {
 	if m.nbCols != other.nbRows {
 		return nil, fmt.Errorf("mismatch between number of columns of first matrix (%d) and number of rows of second matrix (%d), cannot perform multiplication", m.nbCols, other.nbRows)
 	}
-	if m.IsZero() || other.IsZero() {
-		return New(m.nbRows, other.nbCols), nil
-	}
-
-	result := New(m.nbRows, other.nbCols)
-	for i := 0; i < m.nbRows; i++ {
-		for j := 0; j < other.nbCols; j++ {
-			idx := 0
-			value := 0.0
-			for idx < m.nbCols {
-				value += m.data[i][idx] * other.data[idx][j]
-				idx++
-			}
 
-			result.data[i][j] = value
-		}
-	}
+	result := NewOf[T](m.nbRows, other.nbCols)
+	mulInto(result, m, other)
 
 	return result, nil
 }
 
-// Returns the determinant of the calling matrix.
+// Performs the matrix-vector product between the receiver matrix and a given vector.
 //
-// It returns an error if the matrix is not square.
-// Internally, it uses a recursive Laplace expansion approach.
-func (m *Matrix) Determinant() (float64, error) {
-	if !m.IsSquare() {
-		return 0.0, fmt.Errorf("cannot compute the determinant of a non-square matrix")
-	}
-	return m.determinantRecursive(), nil
-}
-
-// Computes the determinant of a square matrix recursively
-// using Laplace expansion along the first row.
-//
-// This helper method assumes the matrix is square and valid.
-func (m *Matrix) determinantRecursive() float64 {
-	if m.nbRows == 1 {
-		return m.data[0][0]
-	}
-	if m.nbRows == 2 {
-		return m.data[0][0]*m.data[1][1] - m.data[0][1]*m.data[1][0]
+// It returns a new vector representing the product m * v. If the number of
+// columns of the matrix does not match the size of the vector, an error is returned.
+func (m *MatrixOf[T]) MulVec(v *vector.VectorOf[T]) (*vector.VectorOf[T], error) {
+	result := vector.NewOf[T](m.nbRows)
+	if err := MulVecTo(result, m, v); err != nil {
+		return nil, err
 	}
 
-	determinant := 0.0
-	for col := 0; col < m.nbCols; col++ {
-		cofactor := m.getMinor(0, col)
-		sign := 1.0
-		if col%2 != 0 {
-			sign = -1.0
-		}
-		determinant += sign * m.data[0][col] * cofactor.determinantRecursive()
-	}
-
-	return determinant
+	return result, nil
 }
 
-// Returns the minor matrix obtained by removing the specified
-// row and column from the current matrix.
+// Returns the determinant of the calling matrix.
 //
-// It is used as a helper in determinant computations.
-// The resulting minor is a deep copy of the reduced matrix.
-func (m *Matrix) getMinor(rowToRemove, colToRemove int) *Matrix {
-	minorData := [][]float64{}
-	for i := 0; i < m.nbRows; i++ {
-		if i == rowToRemove {
-			continue
-		}
-		row := []float64{}
-		for j := 0; j < m.nbCols; j++ {
-			if j == colToRemove {
-				continue
-			}
-			row = append(row, m.data[i][j])
-		}
-		minorData = append(minorData, row)
+// It returns an error if the matrix is not square.
+// Internally, it uses the LU factorization with partial pivoting.
+// By convention, the determinant of an empty (0x0) matrix is zero.
+func (m *MatrixOf[T]) Determinant() (T, error) {
+	lu, err := m.LU()
+	if err != nil {
+		return 0.0, fmt.Errorf("cannot compute the determinant of a non-square matrix")
 	}
-	minor, _ := NewFromData(minorData)
-	return minor
+	return T(lu.Determinant()), nil
 }
 
 // Returns a new matrix that is the Row Echelon Form (REF)
@@ -447,23 +570,22 @@ func (m *Matrix) getMinor(rowToRemove, colToRemove int) *Matrix {
 //
 // The method applies Gaussian elimination without row scaling,
 // and does not modify the original matrix.
-func (m *Matrix) ToRowEchelon() *Matrix {
+// See RREF for the reduced form along with the pivot columns.
+func (m *MatrixOf[T]) ToRowEchelon() *MatrixOf[T] {
 	if m.nbRows == 0 || m.nbCols == 0 {
-		return New(m.nbRows, m.nbCols)
+		return NewOf[T](m.nbRows, m.nbCols)
 	}
 
 	// Copy matrix
-	ref, err := NewFromData(m.data)
-	if err != nil {
-		panic(fmt.Sprintf("invalid matrix data: %v", err))
-	}
+	ref := m.Copy()
+	tolerance := vector.Tolerance[T](pivotTolerance)
 
 	row := 0
 	for col := 0; col < ref.nbCols && row < ref.nbRows; col++ {
 		// Find pivot in the current column
 		pivotRow := -1
 		for r := row; r < ref.nbRows; r++ {
-			if math.Abs(ref.data[r][col]) > 1e-10 {
+			if math.Abs(float64(ref.row(r)[col])) > tolerance {
 				pivotRow = r
 				// Pivot found so break here
 				break
@@ -476,13 +598,15 @@ func (m *Matrix) ToRowEchelon() *Matrix {
 		}
 		// Swap current row with pivot row
 		if pivotRow != row {
-			ref.data[row], ref.data[pivotRow] = ref.data[pivotRow], ref.data[row]
+			ref.swapRows(row, pivotRow)
 		}
 		// Eliminate below
+		pivotValues := ref.row(row)
 		for r := row + 1; r < ref.nbRows; r++ {
-			factor := ref.data[r][col] / ref.data[row][col]
+			values := ref.row(r)
+			factor := values[col] / pivotValues[col]
 			for c := col; c < ref.nbCols; c++ {
-				ref.data[r][c] -= factor * ref.data[row][c]
+				values[c] -= factor * pivotValues[c]
 			}
 		}
 		row++
@@ -493,32 +617,18 @@ func (m *Matrix) ToRowEchelon() *Matrix {
 
 // Returns the rank of the matrix.
 //
-// The rank is computed by transforming the matrix into its Row Echelon Form (REF)
-// using Gaussian elimination and counting the number of non-zero rows.
-func (m *Matrix) Rank() int {
-	ref := m.ToRowEchelon()
-	rank := 0
-	for _, row := range ref.data {
-		notZero := false
-		for _, val := range row {
-			if math.Abs(val) > 1e-10 {
-				notZero = true
-				break
-			}
-		}
-		if notZero {
-			rank++
-		}
-	}
-
-	return rank
+// The rank is computed from the QR decomposition with column pivoting,
+// by counting the diagonal elements of R that are not negligible compared
+// to the largest one. See QR.Rank for the tolerance being used.
+func (m *MatrixOf[T]) Rank() int {
+	return m.QRPivoted().Rank()
 }
 
 // Tells whether the matrix is full rank.
 //
 // A matrix is full rank if its rank is equal to the smaller of its number
 // of rows and columns.
-func (m *Matrix) IsFullRank() bool {
+func (m *MatrixOf[T]) IsFullRank() bool {
 	rank := m.Rank()
 	min := min(m.nbRows, m.nbCols)
 
@@ -528,115 +638,42 @@ func (m *Matrix) IsFullRank() bool {
 // Tells if a matrix is invertible
 //
 // Namely:
-//   - it is full rank
 //   - it is squared
-//   - its determinant is not zero
-func (m *Matrix) IsInvertible() bool {
-	// Check if is full rank
-	if !m.IsFullRank() {
-		return false
-	}
-	// Must be squared
-	if !m.IsSquare() {
-		return false
-	}
-	// Determinant must be non-zero
-	determinant, err := m.Determinant()
-	if err != nil || math.Abs(determinant) < 1e-10 {
+//   - none of the pivots of its LU factorization is zero
+func (m *MatrixOf[T]) IsInvertible() bool {
+	lu, err := m.LU()
+	if err != nil {
 		return false
 	}
 
-	return true
+	return !lu.IsSingular()
 }
 
 // Invert returns the inverse of the matrix.
 //
-// For 2x2 matrices, it uses a "closed-form" formula.
-// For larger square matrices, it applies Gaussian elimination with pivoting.
+// It computes the LU factorization with partial pivoting of the matrix,
+// then solves for each column of the identity matrix.
 //
 // Returns an error if the matrix is not square or is singular (non-invertible).
 // The original matrix is not modified.
-func (m *Matrix) Invert() (*Matrix, error) {
-	if !m.IsInvertible() {
-		return nil, fmt.Errorf("matrix is singular, cannot invert")
-
-	}
-	if !m.IsSquare() {
+func (m *MatrixOf[T]) Invert() (*MatrixOf[T], error) {
+	lu, err := m.LU()
+	if err != nil {
 		return nil, fmt.Errorf("matrix is not squared, it cannot be inverted")
 	}
-	// 2x2 matrix
-	if m.nbCols == 2 {
-		a := m.data[0][0]
-		b := m.data[0][1]
-		c := m.data[1][0]
-		d := m.data[1][1]
-		det := a*d - b*c
-		if math.Abs(det) < 1e-10 {
-			return nil, fmt.Errorf("matrix determinant is zero, cannot invert")
-		}
-		invDet := 1.0 / det
-		return NewFromData([][]float64{
-			{d * invDet, -b * invDet},
-			{-c * invDet, a * invDet},
-		})
-	}
-	// General case
-	matrixCopy, err := NewFromData(m.data)
-	if err != nil {
-		return nil, fmt.Errorf("invalid matrix: %w", err)
-	}
-	// Start with identity matrix that will be transformed into inverse at the end of the algorithm
-	invMatrix := NewIdentity(m.nbRows)
-
-	n := m.nbRows
-	for i := 0; i < n; i++ {
-		// Find pivot element (max absolute value in column i at or below row i)
-		pivot := i
-		maxVal := math.Abs(matrixCopy.data[i][i])
-		for r := i + 1; r < n; r++ {
-			if math.Abs(matrixCopy.data[r][i]) > maxVal {
-				maxVal = math.Abs(matrixCopy.data[r][i])
-				pivot = r
-			}
-		}
-
-		// Matrix cannot be inverted
-		if maxVal < 1e-10 {
-			return nil, fmt.Errorf("matrix is singular, cannot invert")
-		}
 
-		// Swap rows in both matrixCopy and invMatrix if needed
-		if pivot != i {
-			matrixCopy.data[i], matrixCopy.data[pivot] = matrixCopy.data[pivot], matrixCopy.data[i]
-			invMatrix.data[i], invMatrix.data[pivot] = invMatrix.data[pivot], invMatrix.data[i]
-		}
-
-		// Normalize pivot row
-		pivotVal := matrixCopy.data[i][i]
-		for col := 0; col < n; col++ {
-			matrixCopy.data[i][col] /= pivotVal
-			invMatrix.data[i][col] /= pivotVal
-		}
-
-		// Eliminate all other rows
-		for row := 0; row < n; row++ {
-			if row != i {
-				factor := matrixCopy.data[row][i]
-				for col := 0; col < n; col++ {
-					matrixCopy.data[row][col] -= factor * matrixCopy.data[i][col]
-					invMatrix.data[row][col] -= factor * invMatrix.data[i][col]
-				}
-			}
-		}
+	inv, err := lu.Inverse()
+	if err != nil {
+		return nil, err
 	}
 
-	return invMatrix, nil
+	return fromFloat64[T](inv), nil
 }
 
 // Performs matrix division by multiplying the current matrix by the inverse of the given matrix.
 //
 // Returns an error if the given matrix is not invertible or if multiplication fails.
-func (m *Matrix) Div(other *Matrix) (*Matrix, error) {
+func (m *MatrixOf[T]) Div(other *MatrixOf[T]) (*MatrixOf[T], error) {
 	invert, err := other.Invert()
 	if err != nil {
 		return nil, fmt.Errorf("could not perform matrix division because given matrix cannot be inverted")
@@ -649,17 +686,18 @@ func (m *Matrix) Div(other *Matrix) (*Matrix, error) {
 	return result, nil
 }
 
-// Helper method that compares two matrices for approximate equality (float64 type).
+// Helper method that compares two matrices for approximate equality.
 //
 // It returns true if both matrices have the same dimensions and each pair of
 // corresponding elements differ by no more than the specified epsilon value.
-func (m *Matrix) EqualsApprox(other *Matrix, epsilon float64) bool {
+func (m *MatrixOf[T]) EqualsApprox(other *MatrixOf[T], epsilon float64) bool {
 	if m.nbRows != other.nbRows || m.nbCols != other.nbCols {
 		return false
 	}
 	for i := 0; i < m.nbRows; i++ {
-		for j := 0; j < m.nbCols; j++ {
-			if math.Abs(m.data[i][j]-other.data[i][j]) > epsilon {
+		mRow, otherRow := m.row(i), other.row(i)
+		for j := range mRow {
+			if math.Abs(float64(mRow[j]-otherRow[j])) > epsilon {
 				return false
 			}
 		}
@@ -671,18 +709,19 @@ func (m *Matrix) EqualsApprox(other *Matrix, epsilon float64) bool {
 //
 // Power 0 returns the identity matrix (only for square matrices).
 // Negative powers compute the inverse of the matrix first (only if invertible).
-func (m *Matrix) Pow(power int) (*Matrix, error) {
+// It uses exponentiation by squaring, which takes O(log(|power|)) multiplications.
+func (m *MatrixOf[T]) Pow(power int) (*MatrixOf[T], error) {
 	// Check if it's square first
 	if !m.IsSquare() {
 		return nil, fmt.Errorf("power %d cannot be computed for a non-square matrix", power)
 	}
 	// Identity matrix for power 0
 	if power == 0 {
-		return NewIdentity(m.nbRows), nil
+		return NewIdentityOf[T](m.nbRows), nil
 	}
 	// The matrix itself, make a copy
 	if power == 1 {
-		return NewFromData(m.data)
+		return m.Copy(), nil
 	}
 
 	base := m
@@ -697,12 +736,23 @@ func (m *Matrix) Pow(power int) (*Matrix, error) {
 		power = -power
 	}
 
-	// Exponentiation by repeated multiplication
-	result := NewIdentity(m.nbRows)
-	for i := 0; i < power; i++ {
-		result, err = result.Mul(base)
-		if err != nil {
-			return nil, fmt.Errorf("matrix multiplication failed: %w", err)
+	// Exponentiation by squaring: base goes through m^(2^k), and is multiplied
+	// into the result for each bit of power that is set, so that it takes
+	// O(log(power)) multiplications
+	result := NewIdentityOf[T](m.nbRows)
+	for power > 0 {
+		if power&1 == 1 {
+			result, err = result.Mul(base)
+			if err != nil {
+				return nil, fmt.Errorf("matrix multiplication failed: %w", err)
+			}
+		}
+		power >>= 1
+		if power > 0 {
+			base, err = base.Mul(base)
+			if err != nil {
+				return nil, fmt.Errorf("matrix multiplication failed: %w", err)
+			}
 		}
 	}
 
@@ -712,15 +762,15 @@ func (m *Matrix) Pow(power int) (*Matrix, error) {
 // Transpose returns the transpose of the matrix.
 //
 // The transpose flips the matrix over its diagonal, converting rows to columns and vice versa.
-func (m *Matrix) Transpose() *Matrix {
+func (m *MatrixOf[T]) Transpose() *MatrixOf[T] {
 	if m.nbRows == 0 || m.nbCols == 0 {
-		return New(0, 0)
+		return NewOf[T](0, 0)
 	}
 
-	result := New(m.nbCols, m.nbRows)
+	result := NewOf[T](m.nbCols, m.nbRows)
 	for i := 0; i < m.nbRows; i++ {
 		for j := 0; j < m.nbCols; j++ {
-			result.data[j][i] = m.data[i][j]
+			result.row(j)[i] = m.row(i)[j]
 		}
 	}
 
@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/JoLandry/linalgo/vector"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -13,7 +14,7 @@ func TestNew(t *testing.T) {
 
 	assert.Equal(t, 2, newMatrix.nbCols)
 	assert.Equal(t, 2, newMatrix.nbRows)
-	assert.Equal(t, newMatrix.data, [][]float64{{0.0, 0.0}, {0.0, 0.0}})
+	assert.Equal(t, newMatrix.GetData(), [][]float64{{0.0, 0.0}, {0.0, 0.0}})
 }
 
 func TestGetData(t *testing.T) {
@@ -24,6 +25,26 @@ func TestGetData(t *testing.T) {
 	assert.Equal(t, data, newMatrix.GetData())
 }
 
+func TestGetData_ReturnsCopy(t *testing.T) {
+	newMatrix, err := NewFromData([][]float64{{1.0, 2.0}, {3.0, 4.0}})
+	assert.NoError(t, err)
+
+	data := newMatrix.GetData()
+	data[0][1] = 99.0
+
+	assert.Equal(t, 2.0, newMatrix.GetElementAt(0, 1))
+}
+
+func TestNew_ContiguousStorage(t *testing.T) {
+	var newMatrix *Matrix = New(3, 4)
+
+	assert.Equal(t, 12, len(newMatrix.data))
+	assert.Equal(t, 4, newMatrix.stride)
+
+	newMatrix.SetElementAt(1, 2, 5.0)
+	assert.Equal(t, 5.0, newMatrix.data[1*4+2])
+}
+
 func TestGetNbRows(t *testing.T) {
 	var newMatrix *Matrix = New(2, 2)
 
@@ -63,7 +84,7 @@ func TestNewFromData_ValidInput(t *testing.T) {
 
 	assert.Equal(t, 2, mat.nbRows)
 	assert.Equal(t, 2, mat.nbCols)
-	assert.Equal(t, [][]float64{{1.0, 2.0}, {3.0, 4.0}}, mat.data)
+	assert.Equal(t, [][]float64{{1.0, 2.0}, {3.0, 4.0}}, mat.GetData())
 }
 
 func TestNewFromData_EmptyInput(t *testing.T) {
@@ -105,7 +126,7 @@ func TestIsZero_ShouldBeTrue(t *testing.T) {
 
 func TestIsZero_ShouldBeFalse(t *testing.T) {
 	var newMatrix *Matrix = New(2, 3)
-	newMatrix.data[0][0] = 1.0
+	newMatrix.SetElementAt(0, 0, 1.0)
 
 	assert.Equal(t, false, newMatrix.IsZero())
 }
@@ -315,6 +336,46 @@ func TestIsDiagonal_ShouldReturnFalse_IfNonDiagonalElementIsNonZero(t *testing.T
 	assert.False(t, m.IsDiagonal())
 }
 
+func TestIsUpperAndLowerTriangular_ShouldReturnTrue_ForEmptyMatrix(t *testing.T) {
+	m := New(0, 0)
+
+	assert.True(t, m.IsUpperTriangular())
+	assert.True(t, m.IsLowerTriangular())
+}
+
+func TestIsUpperTriangular(t *testing.T) {
+	m, err := NewFromData([][]float64{
+		{1, 2, 3},
+		{0, 4, 5},
+		{0, 0, 6},
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, m.IsUpperTriangular())
+	assert.False(t, m.IsLowerTriangular())
+	assert.True(t, m.Transpose().IsLowerTriangular())
+}
+
+func TestIsUpperAndLowerTriangular_ShouldReturnFalse(t *testing.T) {
+	full, _ := NewFromData([][]float64{
+		{1, 2},
+		{3, 4},
+	})
+	nonSquare := New(2, 3)
+
+	assert.False(t, full.IsUpperTriangular())
+	assert.False(t, full.IsLowerTriangular())
+	assert.False(t, nonSquare.IsUpperTriangular())
+	assert.False(t, nonSquare.IsLowerTriangular())
+}
+
+func TestIsUpperAndLowerTriangular_DiagonalMatrix(t *testing.T) {
+	m := NewDiagonal([]float64{1, 2, 3})
+
+	assert.True(t, m.IsUpperTriangular())
+	assert.True(t, m.IsLowerTriangular())
+}
+
 func TestIsHollow_ShouldReturnTrue_ForEmptyMatrix(t *testing.T) {
 	m, err := NewFromData([][]float64{})
 
@@ -1004,8 +1065,8 @@ func TestNewFromFlat_Correct(t *testing.T) {
 
 	for i := range expected {
 		for j := range expected[i] {
-			if m.data[i][j] != expected[i][j] {
-				t.Errorf("expected m[%d][%d] = %f, got %f", i, j, expected[i][j], m.data[i][j])
+			if m.GetElementAt(i, j) != expected[i][j] {
+				t.Errorf("expected m[%d][%d] = %f, got %f", i, j, expected[i][j], m.GetElementAt(i, j))
 			}
 		}
 	}
@@ -1028,11 +1089,53 @@ func TestNewDiagonal_Correct(t *testing.T) {
 
 	for i := 0; i < 3; i++ {
 		for j := 0; j < 3; j++ {
-			if i == j && m.data[i][j] != diag[i] {
-				t.Errorf("expected diagonal element m[%d][%d] = %f, got %f", i, j, diag[i], m.data[i][j])
-			} else if i != j && m.data[i][j] != 0.0 {
-				t.Errorf("expected off-diagonal element m[%d][%d] = 0.0, got %f", i, j, m.data[i][j])
+			if i == j && m.GetElementAt(i, j) != diag[i] {
+				t.Errorf("expected diagonal element m[%d][%d] = %f, got %f", i, j, diag[i], m.GetElementAt(i, j))
+			} else if i != j && m.GetElementAt(i, j) != 0.0 {
+				t.Errorf("expected off-diagonal element m[%d][%d] = 0.0, got %f", i, j, m.GetElementAt(i, j))
 			}
 		}
 	}
 }
+
+func TestMulVec_ShouldSucceed(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{1, 2, 3},
+		{4, 5, 6},
+	})
+	v := vector.NewFromData([]float64{1, 0, -1})
+
+	result, err := m.MulVec(v)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{-2, -2}, result.GetData())
+}
+
+func TestMulVec_ShouldFail_DimensionMismatch(t *testing.T) {
+	m := New(2, 3)
+	v := vector.New(2)
+
+	_, err := m.MulVec(v)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "mismatch between number of columns of matrix")
+}
+
+func TestHadamard(t *testing.T) {
+	m1, _ := NewFromData([][]float64{{1, -2}, {0, 4}})
+	m2, _ := NewFromData([][]float64{{3, 3}, {2, 0.5}})
+
+	product, err := m1.Hadamard(m2)
+	require.NoError(t, err)
+	quotient, err := m1.HadamardDiv(m2)
+	require.NoError(t, err)
+
+	assert.Equal(t, [][]float64{{3, -6}, {0, 2}}, product.GetData())
+	assert.InDelta(t, 1.0/3.0, quotient.GetElementAt(0, 0), 1e-15)
+	assert.Equal(t, 8.0, quotient.GetElementAt(1, 1))
+
+	_, err = m1.Hadamard(New(2, 3))
+	assert.Error(t, err)
+	_, err = m1.HadamardDiv(New(2, 3))
+	assert.Error(t, err)
+}
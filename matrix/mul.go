@@ -0,0 +1,120 @@
+package matrix
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/JoLandry/linalgo/vector"
+)
+
+const (
+	// Side of the square tiles used by the blocked multiplication kernel.
+	// Three 64x64 tiles of float64 fit in a typical 128KB L2 cache.
+	mulBlockSize = 64
+	// Below this number of multiply-adds, the simple loop is faster than
+	// the blocked and parallel kernel.
+	mulSmallThreshold = 64 * 64 * 64
+)
+
+// Number of goroutines used by Mul, zero meaning runtime.GOMAXPROCS(0)
+var mulWorkers atomic.Int64
+
+// Sets the number of goroutines used to multiply large matrices.
+//
+// A value lower than or equal to zero restores the default, namely runtime.GOMAXPROCS(0).
+// It is safe to call SetMulWorkers concurrently with matrix multiplications.
+func SetMulWorkers(workers int) {
+	if workers < 0 {
+		workers = 0
+	}
+	mulWorkers.Store(int64(workers))
+}
+
+// Returns the number of goroutines used to multiply large matrices.
+func MulWorkers() int {
+	if workers := int(mulWorkers.Load()); workers > 0 {
+		return workers
+	}
+
+	return runtime.GOMAXPROCS(0)
+}
+
+// Accumulates the product a * b into dst, namely dst += a * b.
+//
+// This helper function assumes the dimensions agree, and that dst shares no storage
+// with a or b. Each element of dst is accumulated by a single goroutine, in increasing
+// order of the inner index, so the result is the same as the simple loop whatever
+// the number of workers.
+func mulInto[T vector.Float](dst, a, b *MatrixOf[T]) {
+	if a.nbRows*a.nbCols*b.nbCols < mulSmallThreshold {
+		mulRows(dst, a, b, 0, a.nbRows)
+		return
+	}
+
+	nbBlocks := (a.nbRows + mulBlockSize - 1) / mulBlockSize
+	workers := min(MulWorkers(), nbBlocks)
+	if workers <= 1 {
+		for block := 0; block < nbBlocks; block++ {
+			mulBlockedRows(dst, a, b, block*mulBlockSize, min((block+1)*mulBlockSize, a.nbRows))
+		}
+		return
+	}
+
+	// Workers pick blocks of rows until none is left
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for {
+				block := int(next.Add(1)) - 1
+				if block >= nbBlocks {
+					return
+				}
+				mulBlockedRows(dst, a, b, block*mulBlockSize, min((block+1)*mulBlockSize, a.nbRows))
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// Accumulates the rows i0 (included) to i1 (excluded) of a * b into dst
+// with the simple loop.
+//
+// Loop order i-k-j walks through contiguous rows of b and dst.
+// Zeros of a are not skipped, so that infinities and NaNs of b propagate (0 * Inf = NaN).
+func mulRows[T vector.Float](dst, a, b *MatrixOf[T], i0, i1 int) {
+	for i := i0; i < i1; i++ {
+		dstRow := dst.row(i)
+		for k, value := range a.row(i) {
+			bRow := b.row(k)
+			for j := range dstRow {
+				dstRow[j] += value * bRow[j]
+			}
+		}
+	}
+}
+
+// Accumulates the rows i0 (included) to i1 (excluded) of a * b into dst,
+// working on tiles of b small enough to stay in cache.
+func mulBlockedRows[T vector.Float](dst, a, b *MatrixOf[T], i0, i1 int) {
+	for k0 := 0; k0 < a.nbCols; k0 += mulBlockSize {
+		k1 := min(k0+mulBlockSize, a.nbCols)
+		for j0 := 0; j0 < b.nbCols; j0 += mulBlockSize {
+			j1 := min(j0+mulBlockSize, b.nbCols)
+			for i := i0; i < i1; i++ {
+				dstRow := dst.row(i)[j0:j1]
+				aRow := a.row(i)
+				for k := k0; k < k1; k++ {
+					value := aRow[k]
+					bRow := b.row(k)[j0:j1]
+					for j := range dstRow {
+						dstRow[j] += value * bRow[j]
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package matrix
+
+import (
+	"math"
+	"math/rand"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRandomMatrix(rng *rand.Rand, nbRows, nbCols int) *Matrix {
+	m := New(nbRows, nbCols)
+	for i := 0; i < nbRows; i++ {
+		for j := 0; j < nbCols; j++ {
+			m.SetElementAt(i, j, rng.Float64()*2-1)
+		}
+	}
+	return m
+}
+
+// Reference product, with the same summation order as the kernel
+func naiveMul(a, b *Matrix) *Matrix {
+	result := New(a.GetNbRows(), b.GetNbCols())
+	for i := 0; i < a.GetNbRows(); i++ {
+		for k := 0; k < a.GetNbCols(); k++ {
+			for j := 0; j < b.GetNbCols(); j++ {
+				result.SetElementAt(i, j, result.GetElementAt(i, j)+a.GetElementAt(i, k)*b.GetElementAt(k, j))
+			}
+		}
+	}
+	return result
+}
+
+func TestMul_LargeMatchesSimpleLoop(t *testing.T) {
+	defer SetMulWorkers(0)
+	rng := rand.New(rand.NewSource(42))
+	// Sizes that are not multiples of the tile size
+	a := newRandomMatrix(rng, 150, 97)
+	b := newRandomMatrix(rng, 97, 130)
+	expected := naiveMul(a, b)
+
+	for _, workers := range []int{1, 3, 8} {
+		SetMulWorkers(workers)
+
+		product, err := a.Mul(b)
+
+		require.NoError(t, err)
+		assert.Equal(t, expected.GetData(), product.GetData(), "workers = %d", workers)
+	}
+}
+
+func TestMul_LargeStridedViews(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	m := newRandomMatrix(rng, 200, 200)
+	a, _ := m.Slice(3, 133, 10, 110)
+	b, _ := m.Slice(50, 150, 70, 190)
+
+	product, err := a.Mul(b)
+
+	require.NoError(t, err)
+	assert.Equal(t, naiveMul(a, b).GetData(), product.GetData())
+}
+
+func TestMul_ZeroOperand(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	a := newRandomMatrix(rng, 100, 100)
+
+	product, err := a.Mul(New(100, 80))
+
+	require.NoError(t, err)
+	assert.True(t, product.IsZero())
+	assert.Equal(t, 80, product.GetNbCols())
+}
+
+func TestMul_PropagatesNaNAndInf(t *testing.T) {
+	defer SetMulWorkers(0)
+	rng := rand.New(rand.NewSource(3))
+
+	// Small (simple loop) and large (blocked kernel) products
+	for _, n := range []int{3, 100} {
+		a := New(n, n)
+		b := newRandomMatrix(rng, n, n)
+		b.SetElementAt(0, 0, math.Inf(1))
+		b.SetElementAt(1, 1, math.NaN())
+
+		for _, workers := range []int{1, 3} {
+			SetMulWorkers(workers)
+
+			product, err := a.Mul(b)
+
+			require.NoError(t, err)
+			// 0 * Inf and 0 * NaN are NaN
+			for i := 0; i < n; i++ {
+				assert.True(t, math.IsNaN(product.GetElementAt(i, 0)), "n = %d, workers = %d", n, workers)
+				assert.True(t, math.IsNaN(product.GetElementAt(i, 1)), "n = %d, workers = %d", n, workers)
+				assert.Equal(t, 0.0, product.GetElementAt(i, 2), "n = %d, workers = %d", n, workers)
+			}
+		}
+	}
+}
+
+func TestSetMulWorkers(t *testing.T) {
+	defer SetMulWorkers(0)
+
+	SetMulWorkers(5)
+	assert.Equal(t, 5, MulWorkers())
+
+	SetMulWorkers(-2)
+	assert.Equal(t, runtime.GOMAXPROCS(0), MulWorkers())
+}
+
+func BenchmarkMul_512(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	x := newRandomMatrix(rng, 512, 512)
+	y := newRandomMatrix(rng, 512, 512)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = x.Mul(y)
+	}
+}
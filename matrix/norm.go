@@ -0,0 +1,261 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+)
+
+// NormKind tells which matrix norm to compute.
+type NormKind int
+
+const (
+	// Square root of the sum of the squares of the elements
+	FrobeniusNorm NormKind = iota
+	// Maximum absolute column sum, induced by the vector 1-norm
+	OneNorm
+	// Maximum absolute row sum, induced by the vector ∞-norm
+	InfNorm
+	// Largest absolute value of the elements (not sub-multiplicative)
+	MaxAbsNorm
+	// Largest singular value, induced by the vector 2-norm
+	SpectralNorm
+)
+
+// String returns a human-readable name of the norm.
+func (k NormKind) String() string {
+	switch k {
+	case FrobeniusNorm:
+		return "Frobenius norm"
+	case OneNorm:
+		return "1-norm"
+	case InfNorm:
+		return "∞-norm"
+	case MaxAbsNorm:
+		return "max-abs norm"
+	case SpectralNorm:
+		return "spectral norm"
+	default:
+		return fmt.Sprintf("NormKind(%d)", int(k))
+	}
+}
+
+// Returns the given norm of the matrix.
+//
+// The sums are accumulated in float64. The spectral norm is computed
+// from the singular value decomposition (see Norm2), the other norms in
+// a single pass over the elements.
+// By convention, the norm of an empty matrix is zero.
+// It panics if kind is not one of the declared norms.
+func (m *MatrixOf[T]) Norm(kind NormKind) T {
+	switch kind {
+	case FrobeniusNorm:
+		return T(math.Sqrt(m.frobeniusSquared()))
+	case OneNorm:
+		return T(m.transposedNormInf())
+	case InfNorm:
+		return T(m.normInf())
+	case MaxAbsNorm:
+		return T(m.maxAbs())
+	case SpectralNorm:
+		return m.Norm2()
+	default:
+		panic(fmt.Sprintf("unknown matrix norm %v", kind))
+	}
+}
+
+// Returns the condition number of the matrix for the given norm,
+// namely ||A|| * ||A^-1||.
+//
+// The spectral condition number is the ratio of the largest to the smallest
+// singular value, and is defined for any matrix. The other ones are computed
+// from the inverse given by the LU factorization, and are only defined for
+// square matrices: an error is returned otherwise.
+// It returns +Inf for numerically singular (or rank-deficient) matrices, as
+// detected by the singular values for the spectral norm, and by the pivots of the
+// LU factorization for the other norms: in both cases, a value is negligible when it
+// is not greater than max(m, n) * eps times the norm of A, where eps is the machine
+// epsilon of T. The result therefore does not depend on the scale of A.
+// By convention, the condition number of an empty matrix is zero.
+// See LU.Cond1Estimate for a cheaper estimate of the 1-norm condition number.
+func (m *MatrixOf[T]) Cond(kind NormKind) (float64, error) {
+	if kind == SpectralNorm {
+		return m.cond2(), nil
+	}
+	if !m.IsSquare() {
+		return 0.0, fmt.Errorf("cannot compute the condition number in %v of a non-square matrix", kind)
+	}
+	if m.nbRows == 0 {
+		return 0.0, nil
+	}
+
+	lu, _ := m.LU()
+	inv, err := lu.scaledInverse()
+	if err != nil {
+		return math.Inf(1), nil
+	}
+
+	return float64(m.Norm(kind)) * inv.Norm(kind), nil
+}
+
+// Returns the ∞-norm (maximum absolute row sum) of the matrix.
+func (m *MatrixOf[T]) normInf() float64 {
+	norm := 0.0
+	for i := 0; i < m.nbRows; i++ {
+		sum := 0.0
+		for _, val := range m.row(i) {
+			sum += math.Abs(float64(val))
+		}
+		norm = math.Max(norm, sum)
+	}
+
+	return norm
+}
+
+// Returns the 1-norm (maximum absolute column sum) of the matrix,
+// namely the ∞-norm of its transpose, without building it.
+func (m *MatrixOf[T]) transposedNormInf() float64 {
+	sums := make([]float64, m.nbCols)
+	for i := 0; i < m.nbRows; i++ {
+		for j, val := range m.row(i) {
+			sums[j] += math.Abs(float64(val))
+		}
+	}
+
+	norm := 0.0
+	for _, sum := range sums {
+		norm = math.Max(norm, sum)
+	}
+
+	return norm
+}
+
+// Returns an estimate of the 1-norm condition number of the factorized matrix,
+// namely ||A||_1 * ||A^-1||_1, without computing the inverse.
+//
+// ||A^-1||_1 is estimated with the method of Hager, as refined by Higham
+// (the one of LAPACK's xGECON), which only solves a few systems with A and A^T
+// using the factorization, for O(n^2) operations instead of O(n^3).
+// The estimate is a lower bound of the actual condition number, and is
+// almost always within a factor 3 of it.
+//
+// It returns +Inf if the matrix is singular compared to its scale, namely if
+// a pivot satisfies |U[k][k]| <= n * eps * ||A||_1, where eps is the machine
+// epsilon of the elements of A.
+// By convention, the condition number of an empty matrix is zero.
+func (f *LU) Cond1Estimate() float64 {
+	n := f.lu.nbRows
+	if n == 0 {
+		return 0.0
+	}
+	if f.isRelativelySingular() {
+		return math.Inf(1)
+	}
+
+	return f.norm1 * f.estimateInverseNorm1()
+}
+
+// Maximum number of iterations of the 1-norm estimator of Hager
+const maxNormEstimateIterations = 5
+
+// Estimates ||A^-1||_1 with the method of Hager and Higham.
+//
+// It maximizes the convex function ||A^-1 * x||_1 over the unit ball of the
+// 1-norm with a gradient ascent, whose iterates are vertices e_j of the ball.
+// The result is compared with ||A^-1 * b||_1 for a vector b of alternating signs,
+// which catches the cases where the ascent stops at a poor local maximum.
+//
+// This helper assumes the factorization is not singular.
+func (f *LU) estimateInverseNorm1() float64 {
+	n := f.lu.nbRows
+	x := New(n, 1)
+	for i := 0; i < n; i++ {
+		x.row(i)[0] = 1.0 / float64(n)
+	}
+
+	estimate := 0.0
+	for k := 0; k < maxNormEstimateIterations; k++ {
+		// y = A^-1 * x
+		y := f.solveColumn(x, false)
+		estimate = y.transposedNormInf()
+
+		// z = A^-T * sign(y) is a subgradient of ||A^-1 * x||_1
+		for i := 0; i < n; i++ {
+			if y.row(i)[0] >= 0.0 {
+				y.row(i)[0] = 1.0
+			} else {
+				y.row(i)[0] = -1.0
+			}
+		}
+		z := f.solveColumn(y, true)
+
+		best := 0
+		dot := 0.0
+		for i := 0; i < n; i++ {
+			if math.Abs(z.row(i)[0]) > math.Abs(z.row(best)[0]) {
+				best = i
+			}
+			dot += z.row(i)[0] * x.row(i)[0]
+		}
+		// No vertex improves the estimate: local maximum reached
+		if k > 0 && math.Abs(z.row(best)[0]) <= dot {
+			break
+		}
+		clear(x.data)
+		x.row(best)[0] = 1.0
+	}
+
+	// Alternative estimate of Higham, with b_i = (-1)^i * (1 + i / (n - 1))
+	b := New(n, 1)
+	for i := 0; i < n; i++ {
+		value := 1.0
+		if n > 1 {
+			value += float64(i) / float64(n-1)
+		}
+		if i%2 == 1 {
+			value = -value
+		}
+		b.row(i)[0] = value
+	}
+	alternative := 2.0 * f.solveColumn(b, false).transposedNormInf() / (3.0 * float64(n))
+
+	return math.Max(estimate, alternative)
+}
+
+// Returns the solution of A * x = b, or of A^T * x = b if transposed is true,
+// where b is a single column.
+//
+// This helper assumes the factorization is not singular.
+func (f *LU) solveColumn(b *Matrix, transposed bool) *Matrix {
+	n := f.lu.nbRows
+	x := New(n, 1)
+	if !transposed {
+		for i, row := range f.pivot {
+			x.row(i)[0] = b.row(row)[0]
+		}
+		f.solveInPlace(x, 0)
+		return x
+	}
+
+	// A^T = U^T * L^T * P, so solve with U^T (forward), then L^T (backward, unit diagonal)
+	w := b.Copy()
+	for i := 0; i < n; i++ {
+		sum := w.row(i)[0]
+		for k := 0; k < i; k++ {
+			sum -= f.lu.row(k)[i] * w.row(k)[0]
+		}
+		w.row(i)[0] = sum / f.lu.row(i)[i]
+	}
+	for i := n - 1; i >= 0; i-- {
+		sum := w.row(i)[0]
+		for k := i + 1; k < n; k++ {
+			sum -= f.lu.row(k)[i] * w.row(k)[0]
+		}
+		w.row(i)[0] = sum
+	}
+	// Undo the permutation
+	for i, row := range f.pivot {
+		x.row(row)[0] = w.row(i)[0]
+	}
+
+	return x
+}
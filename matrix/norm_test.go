@@ -0,0 +1,180 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestHilbert(n int) *Matrix {
+	m := New(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			m.SetElementAt(i, j, 1.0/float64(i+j+1))
+		}
+	}
+	return m
+}
+
+func TestNorm(t *testing.T) {
+	m := NewFromFlat(2, 2, []float64{1, -2, -3, 4})
+
+	assert.InDelta(t, math.Sqrt(30), m.Norm(FrobeniusNorm), 1e-12)
+	assert.Equal(t, 6.0, m.Norm(OneNorm))
+	assert.Equal(t, 7.0, m.Norm(InfNorm))
+	assert.Equal(t, 4.0, m.Norm(MaxAbsNorm))
+	assert.InDelta(t, m.Norm2(), m.Norm(SpectralNorm), 1e-12)
+	// The spectral norm is bounded by the Frobenius norm
+	assert.Less(t, m.Norm(SpectralNorm), m.Norm(FrobeniusNorm))
+}
+
+func TestNorm_NonSquareAndEmpty(t *testing.T) {
+	m := newSequenceMatrix(2, 3)
+
+	assert.Equal(t, 9.0, m.Norm(OneNorm))
+	assert.Equal(t, 15.0, m.Norm(InfNorm))
+	assert.Equal(t, m.Norm(InfNorm), m.Transpose().Norm(OneNorm))
+	for _, kind := range []NormKind{FrobeniusNorm, OneNorm, InfNorm, MaxAbsNorm, SpectralNorm} {
+		assert.Equal(t, 0.0, New(0, 0).Norm(kind), kind.String())
+	}
+}
+
+func TestNorm_Float32(t *testing.T) {
+	m := Convert[float32](NewFromFlat(2, 2, []float64{1, -2, -3, 4}))
+
+	var norm float32 = m.Norm(OneNorm)
+
+	assert.Equal(t, float32(6), norm)
+}
+
+func TestNorm_ShouldPanic_UnknownKind(t *testing.T) {
+	assert.Panics(t, func() { NewIdentity(2).Norm(NormKind(42)) })
+	assert.Equal(t, "NormKind(42)", NormKind(42).String())
+}
+
+func TestCond_Norms(t *testing.T) {
+	m := NewFromFlat(2, 2, []float64{1, -2, -3, 4})
+
+	oneCond, err := m.Cond(OneNorm)
+	require.NoError(t, err)
+	infCond, err := m.Cond(InfNorm)
+	require.NoError(t, err)
+	frobeniusCond, err := m.Cond(FrobeniusNorm)
+	require.NoError(t, err)
+
+	// The inverse is [[-2, -1], [-1.5, -0.5]]
+	assert.InDelta(t, 21.0, oneCond, 1e-9)
+	assert.InDelta(t, 21.0, infCond, 1e-9)
+	assert.InDelta(t, 15.0, frobeniusCond, 1e-9)
+}
+
+func TestCond_SingularAndEmpty(t *testing.T) {
+	singular, _ := NewFromData([][]float64{
+		{1, 2},
+		{2, 4},
+	})
+	// Singular, but rounding errors leave a tiny non-zero singular value
+	roundedSingular := newSequenceMatrix(3, 3)
+
+	for _, kind := range []NormKind{FrobeniusNorm, OneNorm, InfNorm, MaxAbsNorm, SpectralNorm} {
+		cond, err := singular.Cond(kind)
+		require.NoError(t, err)
+		roundedCond, err := roundedSingular.Cond(kind)
+		require.NoError(t, err)
+		emptyCond, err := New(0, 0).Cond(kind)
+		require.NoError(t, err)
+
+		assert.True(t, math.IsInf(cond, 1), kind.String())
+		assert.True(t, math.IsInf(roundedCond, 1), kind.String())
+		assert.Equal(t, 0.0, emptyCond, kind.String())
+	}
+}
+
+func TestCond_ScaleInvariant(t *testing.T) {
+	// Below the absolute pivot tolerance, but perfectly conditioned
+	m := NewIdentity(3).MulScalar(1e-11)
+
+	for _, kind := range []NormKind{FrobeniusNorm, OneNorm, InfNorm, MaxAbsNorm, SpectralNorm} {
+		cond, err := m.Cond(kind)
+		require.NoError(t, err)
+		identityCond, err := NewIdentity(3).Cond(kind)
+		require.NoError(t, err)
+
+		assert.InDelta(t, identityCond, cond, 1e-9, kind.String())
+	}
+}
+
+func TestCond_NonSquare(t *testing.T) {
+	m := newSequenceMatrix(3, 2)
+
+	_, err := m.Cond(OneNorm)
+	assert.Error(t, err)
+	cond, err := m.Cond(SpectralNorm)
+	require.NoError(t, err)
+	assert.Greater(t, cond, 1.0)
+}
+
+func TestLU_Cond1Estimate(t *testing.T) {
+	for _, m := range []*Matrix{
+		NewFromFlat(2, 2, []float64{1, -2, -3, 4}),
+		newTestHilbert(6),
+		NewFromFlat(4, 4, []float64{
+			4, -1, 0, 2,
+			1, 5, -2, 0,
+			0, 3, -6, 1,
+			2, 0, 1, 3,
+		}),
+	} {
+		lu, err := m.LU()
+		require.NoError(t, err)
+		exact, err := m.Cond(OneNorm)
+		require.NoError(t, err)
+
+		estimate := lu.Cond1Estimate()
+
+		// A lower bound, within a factor 3 of the actual value
+		assert.LessOrEqual(t, estimate, exact*(1+1e-9))
+		assert.GreaterOrEqual(t, estimate, exact/3)
+	}
+}
+
+func TestLU_Cond1Estimate_FlagsIllConditioned(t *testing.T) {
+	lu, _ := newTestHilbert(10).LU()
+
+	assert.Greater(t, lu.Cond1Estimate(), 1e12)
+}
+
+func TestLU_Cond1Estimate_ScaleInvariant(t *testing.T) {
+	lu, _ := NewIdentity(3).MulScalar(1e-11).LU()
+
+	assert.InDelta(t, 1.0, lu.Cond1Estimate(), 1e-9)
+}
+
+func TestLU_Cond1Estimate_SingularAndEmpty(t *testing.T) {
+	singular, _ := NewFromData([][]float64{
+		{1, 2},
+		{2, 4},
+	})
+	lu, _ := singular.LU()
+	empty, _ := New(0, 0).LU()
+
+	assert.True(t, math.IsInf(lu.Cond1Estimate(), 1))
+	assert.Equal(t, 0.0, empty.Cond1Estimate())
+}
+
+func TestLU_SolveColumn_Transposed(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{0, 2, 1},
+		{1, 1, 1},
+		{2, 1, 3},
+	})
+	b := NewFromFlat(3, 1, []float64{1, -2, 3})
+	lu, _ := m.LU()
+
+	x := lu.solveColumn(b, true)
+	product, _ := m.Transpose().Mul(x)
+
+	assert.True(t, product.EqualsApprox(b, 1e-12))
+}
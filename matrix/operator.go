@@ -0,0 +1,157 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/JoLandry/linalgo/vector"
+)
+
+// LinearOperatorOf is a linear map that can be applied to vectors of elements
+// of type T, without being necessarily stored as a matrix.
+type LinearOperatorOf[T vector.Float] interface {
+	// Returns the number of rows and the number of columns of the operator.
+	Dims() (int, int)
+	// Stores the product of the operator and x into y, which must not be x.
+	// Returns an error if the sizes of x and y do not match the operator.
+	Apply(x, y *vector.VectorOf[T]) error
+}
+
+// LinearOperator is a linear map that can be applied to vectors, without being
+// necessarily stored as a matrix.
+//
+// It is implemented by *Matrix, by the transpose returned by Matrix.T, and by the
+// sparse matrices of the sparse package. Users can implement it for operators they
+// never want to materialize, such as convolutions or Jacobian-vector products.
+type LinearOperator = LinearOperatorOf[float64]
+
+// Returns the number of rows and the number of columns of the matrix.
+func (m *MatrixOf[T]) Dims() (int, int) {
+	return m.nbRows, m.nbCols
+}
+
+// Stores the product of the matrix and x into y, without allocating.
+//
+// Returns an error if the dimensions do not agree or if y is x.
+func (m *MatrixOf[T]) Apply(x, y *vector.VectorOf[T]) error {
+	return MulVecTo(y, m, x)
+}
+
+// Returns the transpose of the matrix as a linear operator, without copying any element.
+//
+// The operator shares the storage of the matrix, so it reflects later modifications.
+func (m *MatrixOf[T]) T() LinearOperatorOf[T] {
+	return transposed[T]{m: m}
+}
+
+// Transpose of a matrix, applied by going through the rows of the matrix.
+type transposed[T vector.Float] struct {
+	m *MatrixOf[T]
+}
+
+func (t transposed[T]) Dims() (int, int) {
+	return t.m.nbCols, t.m.nbRows
+}
+
+func (t transposed[T]) Apply(x, y *vector.VectorOf[T]) error {
+	m := t.m
+	if x.GetSize() != m.nbRows {
+		return fmt.Errorf("mismatch between number of rows of matrix (%d) and size of vector (%d), cannot perform transposed multiplication", m.nbRows, x.GetSize())
+	}
+	if y.GetSize() != m.nbCols {
+		return fmt.Errorf("destination of size %d cannot hold the result of size %d, cannot perform transposed multiplication", y.GetSize(), m.nbCols)
+	}
+	if sameVector(x, y) {
+		return fmt.Errorf("destination is the multiplied vector, cannot perform transposed multiplication")
+	}
+
+	values, result := x.GetData(), y.GetData()
+	clear(result)
+	for i, xi := range values {
+		for j, val := range m.row(i) {
+			result[j] += val * xi
+		}
+	}
+
+	return nil
+}
+
+// Estimates the dominant eigenvalue of a square operator (the one of largest
+// absolute value) and an associated unit eigenvector, with the power iteration.
+//
+// The iteration stops once ||A * x - lambda * x|| <= tolerance * |lambda|.
+// It converges when the dominant eigenvalue is real and strictly larger in absolute
+// value than the others, at a rate given by the ratio of the two largest ones.
+//
+// When an iterate lies in the kernel of the operator, the iteration restarts from
+// the canonical basis vectors in turn, so that an eigenvalue of zero is only
+// reported once the iterates from all of them have ended in the kernel, as
+// happens for a zero or nilpotent operator.
+//
+// Returns an error if the operator is not square, or if the iteration does not
+// converge within maxIterations iterations.
+func PowerIteration(op LinearOperator, tolerance float64, maxIterations int) (float64, *vector.Vector, error) {
+	nbRows, nbCols := op.Dims()
+	if nbRows != nbCols {
+		return 0.0, nil, fmt.Errorf("operator is not square (%dx%d), cannot run the power iteration", nbRows, nbCols)
+	}
+	n := nbRows
+	if n == 0 {
+		return 0.0, vector.New(0), nil
+	}
+
+	// Start from a vector unlikely to be orthogonal to the dominant eigenvector
+	x := vector.New(n)
+	for i := 0; i < n; i++ {
+		x.SetElementAt(i, 1.0+float64(i)/float64(n))
+	}
+	x.MulScalarInPlace(1.0 / x.Norm())
+	y := vector.New(n)
+	residual := vector.New(n)
+	// Number of canonical vectors already tried after landing in the kernel
+	restarts := 0
+
+	for iteration := 0; iteration < maxIterations; iteration++ {
+		if err := op.Apply(x, y); err != nil {
+			return 0.0, nil, err
+		}
+		norm := y.Norm()
+		if norm == 0.0 {
+			// x is in the kernel, which says nothing about the other eigenvalues:
+			// restart from the canonical vectors, which span the whole space
+			if restarts == n {
+				return 0.0, x, nil
+			}
+			clear(x.GetData())
+			x.SetElementAt(restarts, 1.0)
+			restarts++
+			continue
+		}
+
+		// Rayleigh quotient, x being a unit vector
+		lambda, _ := vector.DotProduct(x, y)
+		_ = vector.AddScaledTo(residual, y, -lambda, x)
+		if residual.Norm() <= tolerance*math.Abs(lambda) {
+			return lambda, x, nil
+		}
+		_ = vector.MulScalarTo(x, y, 1.0/norm)
+	}
+
+	return 0.0, nil, fmt.Errorf("power iteration did not converge after %d iterations", maxIterations)
+}
+
+// Checks the dimensions of the product of a nbRows x nbCols operator and x,
+// stored into y, and that y is not x.
+func checkApply[T vector.Float](x, y *vector.VectorOf[T], nbRows, nbCols int) error {
+	if x.GetSize() != nbCols {
+		return fmt.Errorf("mismatch between number of columns of matrix (%d) and size of vector (%d), cannot perform multiplication", nbCols, x.GetSize())
+	}
+	if y.GetSize() != nbRows {
+		return fmt.Errorf("destination of size %d cannot hold the result of size %d, cannot perform multiplication", y.GetSize(), nbRows)
+	}
+	if sameVector(x, y) {
+		return fmt.Errorf("destination is the multiplied vector, cannot perform multiplication")
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package matrix
+
+import (
+	"testing"
+
+	"github.com/JoLandry/linalgo/vector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatrix_IsLinearOperator(t *testing.T) {
+	var op LinearOperator = newSequenceMatrix(2, 3)
+	x := vector.NewFromData([]float64{1, 0, -1})
+	y := vector.New(2)
+
+	nbRows, nbCols := op.Dims()
+	require.NoError(t, op.Apply(x, y))
+
+	assert.Equal(t, 2, nbRows)
+	assert.Equal(t, 3, nbCols)
+	assert.Equal(t, []float64{-2, -2}, y.GetData())
+	assert.Error(t, op.Apply(vector.New(2), y))
+}
+
+func TestT_AppliesTranspose(t *testing.T) {
+	m := newSequenceMatrix(2, 3)
+	op := m.T()
+	x := vector.NewFromData([]float64{1, -1})
+	y := vector.New(3)
+
+	nbRows, nbCols := op.Dims()
+	require.NoError(t, op.Apply(x, y))
+	expected, _ := m.Transpose().MulVec(x)
+
+	assert.Equal(t, 3, nbRows)
+	assert.Equal(t, 2, nbCols)
+	assert.Equal(t, expected.GetData(), y.GetData())
+}
+
+func TestT_SharesStorage(t *testing.T) {
+	m := NewIdentity(2)
+	op := m.T()
+	m.SetElementAt(0, 1, 5)
+	y := vector.New(2)
+
+	require.NoError(t, op.Apply(vector.NewFromData([]float64{1, 0}), y))
+
+	assert.Equal(t, []float64{1, 5}, y.GetData())
+}
+
+func TestT_ShouldFail_InvalidVectors(t *testing.T) {
+	op := NewIdentity(2).T()
+	x := vector.New(2)
+
+	assert.ErrorContains(t, op.Apply(vector.New(3), x), "mismatch")
+	assert.ErrorContains(t, op.Apply(x, vector.New(3)), "destination of size 3")
+	assert.ErrorContains(t, op.Apply(x, x), "destination is the multiplied vector")
+}
+
+func TestPowerIteration_Symmetric(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{2, 1, 0},
+		{1, 3, 1},
+		{0, 1, 4},
+	})
+	eigen, err := m.EigenSym()
+	require.NoError(t, err)
+	values := eigen.Values()
+
+	lambda, v, err := PowerIteration(m, 1e-10, 1000)
+
+	require.NoError(t, err)
+	assert.InDelta(t, values[2], lambda, 1e-8)
+	assert.InDelta(t, 1.0, v.Norm(), 1e-12)
+	mv, _ := m.MulVec(v)
+	assert.InDeltaSlice(t, v.MulScalar(lambda).GetData(), mv.GetData(), 1e-8)
+}
+
+func TestPowerIteration_NegativeDominantEigenvalue(t *testing.T) {
+	m := NewDiagonal([]float64{1, -3, 2})
+
+	lambda, _, err := PowerIteration(m.T(), 1e-10, 1000)
+
+	require.NoError(t, err)
+	assert.InDelta(t, -3.0, lambda, 1e-8)
+}
+
+func TestPowerIteration_ZeroOperator(t *testing.T) {
+	lambda, v, err := PowerIteration(New(3, 3), 1e-10, 10)
+
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, lambda)
+	assert.Equal(t, 3, v.GetSize())
+}
+
+func TestPowerIteration_StartInKernel(t *testing.T) {
+	// Eigenvalues 1 and 0, the starting vector (1, 1.5) is in the kernel
+	m, _ := NewFromData([][]float64{
+		{3, -2},
+		{3, -2},
+	})
+
+	lambda, v, err := PowerIteration(m, 1e-10, 100)
+
+	require.NoError(t, err)
+	assert.InDelta(t, 1.0, lambda, 1e-8)
+	mv, _ := m.MulVec(v)
+	assert.InDeltaSlice(t, v.GetData(), mv.GetData(), 1e-8)
+}
+
+func TestPowerIteration_Nilpotent(t *testing.T) {
+	// All eigenvalues are zero, but the operator is not
+	m, _ := NewFromData([][]float64{
+		{0, 1},
+		{0, 0},
+	})
+
+	lambda, _, err := PowerIteration(m, 1e-10, 100)
+
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, lambda)
+}
+
+func TestPowerIteration_ShouldFail(t *testing.T) {
+	// Complex eigenvalues +-i: the iteration cycles
+	rotation, _ := NewFromData([][]float64{{0, -1}, {1, 0}})
+
+	_, _, errNonSquare := PowerIteration(New(2, 3), 1e-10, 10)
+	_, _, errConvergence := PowerIteration(rotation, 1e-10, 50)
+
+	assert.ErrorContains(t, errNonSquare, "not square")
+	assert.ErrorContains(t, errConvergence, "did not converge")
+}
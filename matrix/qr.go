@@ -0,0 +1,267 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/JoLandry/linalgo/vector"
+)
+
+// Machine epsilon for float64 values (distance between 1.0 and the next float64).
+var machineEpsilon = math.Nextafter(1.0, 2.0) - 1.0
+
+// QR holds the QR decomposition of a m x n matrix, computed with Householder reflections.
+//
+// The decomposition satisfies A * P = Q * R, where Q is a m x m orthogonal matrix,
+// R is a m x n upper triangular matrix and P is a n x n permutation matrix.
+// Without column pivoting, P is the identity matrix.
+type QR struct {
+	// Householder vectors (on and below the diagonal) and R (above the diagonal) packed together
+	qr *Matrix
+	// Diagonal of R
+	rDiag []float64
+	// perm[j] is the column of A that ended up at column j
+	perm []int
+	// Machine epsilon of the elements of A, used by Rank
+	epsilon float64
+}
+
+// Computes and returns the QR decomposition of the matrix using Householder reflections.
+//
+// It works for any m x n matrix. The decomposition is computed in float64.
+// The original matrix is not modified.
+func (m *MatrixOf[T]) QR() *QR {
+	return householderQR(float64Of(m), false, vector.Epsilon[T]())
+}
+
+// Computes and returns the QR decomposition with column pivoting of the matrix.
+//
+// At each step, the remaining column of largest norm is moved to the front, so
+// that the diagonal of R is non-increasing in absolute value. This makes the
+// decomposition rank-revealing, see QR.Rank.
+// The original matrix is not modified.
+func (m *MatrixOf[T]) QRPivoted() *QR {
+	return householderQR(float64Of(m), true, vector.Epsilon[T]())
+}
+
+// Computes the Householder QR decomposition, with or without column pivoting,
+// of a matrix whose elements have the given machine epsilon.
+func householderQR(m *Matrix, pivoted bool, epsilon float64) *QR {
+	qr := m.Copy()
+	nbRows, nbCols := m.nbRows, m.nbCols
+	steps := min(nbRows, nbCols)
+	rDiag := make([]float64, steps)
+	perm := make([]int, nbCols)
+	for j := range perm {
+		perm[j] = j
+	}
+
+	for k := 0; k < steps; k++ {
+		if pivoted {
+			// Bring the remaining column of largest norm to position k
+			best := k
+			bestNorm := -1.0
+			for j := k; j < nbCols; j++ {
+				norm := 0.0
+				for i := k; i < nbRows; i++ {
+					norm = math.Hypot(norm, qr.row(i)[j])
+				}
+				if norm > bestNorm {
+					bestNorm = norm
+					best = j
+				}
+			}
+			if best != k {
+				for i := 0; i < nbRows; i++ {
+					qr.row(i)[k], qr.row(i)[best] = qr.row(i)[best], qr.row(i)[k]
+				}
+				perm[k], perm[best] = perm[best], perm[k]
+			}
+		}
+
+		// Norm of the k-th column below the diagonal
+		norm := 0.0
+		for i := k; i < nbRows; i++ {
+			norm = math.Hypot(norm, qr.row(i)[k])
+		}
+		if norm == 0.0 {
+			rDiag[k] = 0.0
+			continue
+		}
+
+		// Form the k-th Householder vector
+		if qr.row(k)[k] < 0 {
+			norm = -norm
+		}
+		for i := k; i < nbRows; i++ {
+			qr.row(i)[k] /= norm
+		}
+		qr.row(k)[k] += 1.0
+
+		// Apply the reflection to the remaining columns
+		for j := k + 1; j < nbCols; j++ {
+			s := 0.0
+			for i := k; i < nbRows; i++ {
+				s += qr.row(i)[k] * qr.row(i)[j]
+			}
+			s = -s / qr.row(k)[k]
+			for i := k; i < nbRows; i++ {
+				qr.row(i)[j] += s * qr.row(i)[k]
+			}
+		}
+		rDiag[k] = -norm
+	}
+
+	return &QR{qr: qr, rDiag: rDiag, perm: perm, epsilon: epsilon}
+}
+
+// Returns the m x m orthogonal factor Q.
+func (f *QR) Q() *Matrix {
+	nbRows := f.qr.nbRows
+	q := NewIdentity(nbRows)
+	for j := 0; j < nbRows; j++ {
+		f.applyQ(q, j)
+	}
+
+	return q
+}
+
+// Returns the m x n upper triangular factor R.
+func (f *QR) R() *Matrix {
+	r := New(f.qr.nbRows, f.qr.nbCols)
+	for i := 0; i < f.qr.nbRows; i++ {
+		for j := i; j < f.qr.nbCols; j++ {
+			if i == j {
+				r.row(i)[j] = f.rDiag[i]
+			} else {
+				r.row(i)[j] = f.qr.row(i)[j]
+			}
+		}
+	}
+
+	return r
+}
+
+// Returns the n x n permutation matrix P such that A * P = Q * R.
+func (f *QR) P() *Matrix {
+	n := f.qr.nbCols
+	p := New(n, n)
+	for j, col := range f.perm {
+		p.row(col)[j] = 1.0
+	}
+
+	return p
+}
+
+// Returns the column permutation of the decomposition.
+//
+// Element j is the index of the column of the original matrix that
+// was moved to column j. The returned slice is a copy.
+func (f *QR) Permutation() []int {
+	perm := make([]int, len(f.perm))
+	copy(perm, f.perm)
+
+	return perm
+}
+
+// Returns the numerical rank of the decomposed matrix.
+//
+// It counts the diagonal elements of R whose absolute value is greater than
+// max(m, n) * eps * max|R[k][k]|, where eps is the machine epsilon of the elements
+// of the decomposed matrix (float32 or float64). The tolerance
+// being relative, the result does not depend on the scaling of the matrix.
+//
+// The rank is only reliable for a decomposition computed with column pivoting.
+func (f *QR) Rank() int {
+	if len(f.rDiag) == 0 {
+		return 0
+	}
+
+	largest := 0.0
+	for _, d := range f.rDiag {
+		largest = math.Max(largest, math.Abs(d))
+	}
+	tolerance := float64(max(f.qr.nbRows, f.qr.nbCols)) * f.epsilon * largest
+
+	rank := 0
+	for _, d := range f.rDiag {
+		if math.Abs(d) > tolerance {
+			rank++
+		}
+	}
+
+	return rank
+}
+
+// Returns the least squares solution x minimizing ||A * x - b||.
+//
+// The decomposed matrix must have at least as many rows as columns and must
+// have full column rank, otherwise an error wrapping ErrSingular is returned.
+// Returns an error if b does not have as many elements as A has rows.
+func (f *QR) SolveLeastSquares(b *vector.Vector) (*vector.Vector, error) {
+	nbRows, nbCols := f.qr.nbRows, f.qr.nbCols
+	if b.GetSize() != nbRows {
+		return nil, fmt.Errorf("mismatch between number of rows of matrix (%d) and size of right-hand side (%d)", nbRows, b.GetSize())
+	}
+	if nbRows < nbCols {
+		return nil, fmt.Errorf("least squares requires at least as many rows (%d) as columns (%d)", nbRows, nbCols)
+	}
+	if f.Rank() < nbCols {
+		return nil, fmt.Errorf("%w: matrix is rank deficient, cannot solve least squares", ErrSingular)
+	}
+
+	// Compute Q^T * b
+	y := NewFromFlat(nbRows, 1, b.GetData())
+	f.applyQT(y, 0)
+
+	// Back substitution with R
+	z := make([]float64, nbCols)
+	for i := nbCols - 1; i >= 0; i-- {
+		sum := y.row(i)[0]
+		for k := i + 1; k < nbCols; k++ {
+			sum -= f.qr.row(i)[k] * z[k]
+		}
+		z[i] = sum / f.rDiag[i]
+	}
+
+	// Undo the column permutation
+	x := vector.New(nbCols)
+	for j, col := range f.perm {
+		x.SetElementAt(col, z[j])
+	}
+
+	return x, nil
+}
+
+// Overwrites column col of x by Q * x[:, col].
+//
+// This helper assumes x has as many rows as the decomposed matrix.
+func (f *QR) applyQ(x *Matrix, col int) {
+	for k := len(f.rDiag) - 1; k >= 0; k-- {
+		f.applyReflection(x, col, k)
+	}
+}
+
+// Overwrites column col of x by Q^T * x[:, col].
+//
+// This helper assumes x has as many rows as the decomposed matrix.
+func (f *QR) applyQT(x *Matrix, col int) {
+	for k := 0; k < len(f.rDiag); k++ {
+		f.applyReflection(x, col, k)
+	}
+}
+
+// Applies the k-th Householder reflection to column col of x.
+func (f *QR) applyReflection(x *Matrix, col int, k int) {
+	if f.qr.row(k)[k] == 0.0 {
+		return
+	}
+	s := 0.0
+	for i := k; i < f.qr.nbRows; i++ {
+		s += f.qr.row(i)[k] * x.row(i)[col]
+	}
+	s = -s / f.qr.row(k)[k]
+	for i := k; i < f.qr.nbRows; i++ {
+		x.row(i)[col] += s * f.qr.row(i)[k]
+	}
+}
@@ -0,0 +1,146 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/JoLandry/linalgo/vector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQR_FactorsReconstructMatrix(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{12, -51, 4},
+		{6, 167, -68},
+		{-4, 24, -41},
+	})
+
+	qr := m.QR()
+	product, err := qr.Q().Mul(qr.R())
+	require.NoError(t, err)
+
+	assert.True(t, product.EqualsApprox(m, 1e-9), "expected %v, got %v", m, product)
+	assert.InDelta(t, 14.0, math.Abs(qr.R().GetElementAt(0, 0)), 1e-9)
+}
+
+func TestQR_QIsOrthogonal(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{1, 2},
+		{3, 4},
+		{5, 6},
+		{7, 8},
+	})
+
+	q := m.QR().Q()
+	qtq, err := q.Transpose().Mul(q)
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, q.nbRows)
+	assert.Equal(t, 4, q.nbCols)
+	assert.True(t, qtq.EqualsApprox(NewIdentity(4), 1e-9))
+}
+
+func TestQR_RIsUpperTriangular_WideMatrix(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{1, 2, 3, 4},
+		{5, 6, 7, 8},
+	})
+
+	qr := m.QR()
+	r := qr.R()
+	product, err := qr.Q().Mul(r)
+	require.NoError(t, err)
+
+	assert.Equal(t, 0.0, r.GetElementAt(1, 0))
+	assert.True(t, product.EqualsApprox(m, 1e-9), "expected %v, got %v", m, product)
+}
+
+func TestQRPivoted_FactorsReconstructPermutedMatrix(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{1, 10, 2},
+		{2, 20, 1},
+		{3, 30, 4},
+	})
+
+	qr := m.QRPivoted()
+	ap, err := m.Mul(qr.P())
+	require.NoError(t, err)
+	product, err := qr.Q().Mul(qr.R())
+	require.NoError(t, err)
+
+	assert.True(t, ap.EqualsApprox(product, 1e-9), "expected %v, got %v", ap, product)
+	// Column of largest norm comes first
+	assert.Equal(t, 1, qr.Permutation()[0])
+
+	r := qr.R()
+	for k := 1; k < 3; k++ {
+		assert.LessOrEqual(t, math.Abs(r.GetElementAt(k, k)), math.Abs(r.GetElementAt(k-1, k-1)))
+	}
+}
+
+func TestQRPivoted_Rank(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{1, 2, 3},
+		{2, 4, 6},
+		{1, 0, 1},
+	})
+
+	assert.Equal(t, 2, m.QRPivoted().Rank())
+	assert.Equal(t, 0, New(3, 2).QRPivoted().Rank())
+}
+
+func TestRank_BadlyScaledMatrix(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{1e-12, 2e-12},
+		{3e-12, 4e-12},
+	})
+	singular, _ := NewFromData([][]float64{
+		{1e12, 2e12},
+		{2e12, 4e12},
+	})
+
+	assert.Equal(t, 2, m.Rank())
+	assert.Equal(t, 1, singular.Rank())
+}
+
+func TestQR_SolveLeastSquares(t *testing.T) {
+	// Fit y = a + b*x through (0, 1), (1, 3), (2, 5) and (3, 8)
+	m, _ := NewFromData([][]float64{
+		{1, 0},
+		{1, 1},
+		{1, 2},
+		{1, 3},
+	})
+	b := vector.NewFromData([]float64{1, 3, 5, 8})
+
+	x, err := m.QRPivoted().SolveLeastSquares(b)
+
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{0.8, 2.3}, x.GetData(), 1e-9)
+}
+
+func TestQR_SolveLeastSquares_ShouldFail_RankDeficient(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{1, 2},
+		{2, 4},
+		{3, 6},
+	})
+	b := vector.NewFromData([]float64{1, 2, 3})
+
+	_, err := m.QR().SolveLeastSquares(b)
+
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, ErrSingular))
+}
+
+func TestQR_SolveLeastSquares_ShouldFail_WideMatrix(t *testing.T) {
+	m := New(2, 3)
+	b := vector.New(2)
+
+	_, err := m.QR().SolveLeastSquares(b)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "at least as many rows")
+}
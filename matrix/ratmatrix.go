@@ -0,0 +1,479 @@
+package matrix
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// RatMatrix represents a two-dimensional matrix of exact rational values.
+//
+// All operations are exact: there is no rounding and no tolerance, at the cost
+// of numbers whose size may grow with the operations. It is meant for teaching
+// and for verifying floating-point results, rather than for large matrices.
+type RatMatrix struct {
+	// Elements in row-major order, never copied by value
+	data   []big.Rat
+	nbRows int
+	nbCols int
+}
+
+// Create a new rational matrix of zeros from a given number of rows and a given number of columns
+func NewRatMatrix(nbRowsMat int, nbColsMat int) *RatMatrix {
+	return &RatMatrix{
+		data:   make([]big.Rat, nbRowsMat*nbColsMat),
+		nbRows: nbRowsMat,
+		nbCols: nbColsMat,
+	}
+}
+
+// Create a new rational matrix from a given 2D slice (deep copy)
+//
+// If the input has no row, then a pointer to a new empty matrix
+// Is returned
+//
+// Return an error if there is any inconsistency in the number of columns,
+// or if an element is nil
+func NewRatMatrixFromData(mData [][]*big.Rat) (*RatMatrix, error) {
+	nbRows := len(mData)
+	if nbRows == 0 {
+		return NewRatMatrix(0, 0), nil
+	}
+
+	nbCols := len(mData[0])
+	result := NewRatMatrix(nbRows, nbCols)
+	for i := range mData {
+		if len(mData[i]) != nbCols {
+			return nil, fmt.Errorf("inconsistent number of columns in row %d: expected %d, got %d", i, nbCols, len(mData[i]))
+		}
+		for j, val := range mData[i] {
+			if val == nil {
+				return nil, fmt.Errorf("nil element at row %d and column %d", i, j)
+			}
+			result.at(i, j).Set(val)
+		}
+	}
+
+	return result, nil
+}
+
+// Create a new rational matrix from a given 2D slice of integers
+//
+// Return an error if there is any inconsistency in the number of columns
+func NewRatMatrixFromInts(mData [][]int64) (*RatMatrix, error) {
+	nbRows := len(mData)
+	if nbRows == 0 {
+		return NewRatMatrix(0, 0), nil
+	}
+
+	nbCols := len(mData[0])
+	result := NewRatMatrix(nbRows, nbCols)
+	for i := range mData {
+		if len(mData[i]) != nbCols {
+			return nil, fmt.Errorf("inconsistent number of columns in row %d: expected %d, got %d", i, nbCols, len(mData[i]))
+		}
+		for j, val := range mData[i] {
+			result.at(i, j).SetInt64(val)
+		}
+	}
+
+	return result, nil
+}
+
+// Creates and returns a new rational identity matrix of a given size.
+func NewRatIdentity(size int) *RatMatrix {
+	result := NewRatMatrix(size, size)
+	for k := 0; k < size; k++ {
+		result.at(k, k).SetInt64(1)
+	}
+
+	return result
+}
+
+// Creates and returns the rational matrix holding exactly the elements of a matrix.
+//
+// Every finite float64 is a rational number, so the conversion is exact:
+// 0.1 becomes 3602879701896397/36028797018963968, not 1/10.
+// Returns an error if an element is not finite (NaN or infinite).
+func NewRatMatrixFromDense(m *Matrix) (*RatMatrix, error) {
+	result := NewRatMatrix(m.nbRows, m.nbCols)
+	for i := 0; i < m.nbRows; i++ {
+		for j, val := range m.row(i) {
+			if result.at(i, j).SetFloat64(val) == nil {
+				return nil, fmt.Errorf("element %v at row %d and column %d is not finite, cannot convert it to a rational", val, i, j)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// Returns the matrix with each element rounded to the nearest float64.
+func (m *RatMatrix) ToDense() *Matrix {
+	result := New(m.nbRows, m.nbCols)
+	for i := 0; i < m.nbRows; i++ {
+		for j := 0; j < m.nbCols; j++ {
+			result.row(i)[j], _ = m.at(i, j).Float64()
+		}
+	}
+
+	return result
+}
+
+// Returns the element at row i and column j, sharing the storage of the matrix.
+//
+// It panics if the indices are out of range, which the flat storage
+// would otherwise map to another element.
+func (m *RatMatrix) at(i, j int) *big.Rat {
+	if i < 0 || i >= m.nbRows || j < 0 || j >= m.nbCols {
+		panic(fmt.Sprintf("index (%d, %d) out of range for a %dx%d rational matrix", i, j, m.nbRows, m.nbCols))
+	}
+	return &m.data[i*m.nbCols+j]
+}
+
+// String returns a human-readable string representation of the rational matrix,
+// with elements written as integers or irreducible fractions.
+func (m *RatMatrix) String() string {
+	if m.nbRows == 0 || m.nbCols == 0 {
+		return "[]"
+	}
+
+	var builder strings.Builder
+	builder.WriteString("[\n")
+	for i := 0; i < m.nbRows; i++ {
+		builder.WriteString("  [")
+		for j := 0; j < m.nbCols; j++ {
+			builder.WriteString(fmt.Sprintf("%8s", m.at(i, j).RatString()))
+			if j < m.nbCols-1 {
+				builder.WriteString(", ")
+			}
+		}
+		builder.WriteString("]")
+		if i < m.nbRows-1 {
+			builder.WriteString(",\n")
+		}
+	}
+	builder.WriteString("\n]")
+
+	return builder.String()
+}
+
+// Get the numbers of Rows of the rational matrix
+func (m *RatMatrix) GetNbRows() int {
+	return m.nbRows
+}
+
+// Get the number of columns of the rational matrix
+func (m *RatMatrix) GetNbCols() int {
+	return m.nbCols
+}
+
+// Returns true if the rational matrix is a square matrix, false otherwise
+func (m *RatMatrix) IsSquare() bool {
+	return m.nbCols == m.nbRows
+}
+
+// Get a copy of the element at indices (row,col)
+//
+// It panics if the indices are out of range.
+func (m *RatMatrix) GetElementAt(row int, col int) *big.Rat {
+	return new(big.Rat).Set(m.at(row, col))
+}
+
+// Set the element at indices (row,col) to the value of elt (copied)
+//
+// It panics if the indices are out of range.
+func (m *RatMatrix) SetElementAt(row int, col int, elt *big.Rat) {
+	m.at(row, col).Set(elt)
+}
+
+// Returns a deep copy of the rational matrix.
+func (m *RatMatrix) Copy() *RatMatrix {
+	result := NewRatMatrix(m.nbRows, m.nbCols)
+	for i := range m.data {
+		result.data[i].Set(&m.data[i])
+	}
+
+	return result
+}
+
+// Tells whether two rational matrices have the same dimensions and exactly the same elements.
+func (m *RatMatrix) Equals(other *RatMatrix) bool {
+	if m.nbRows != other.nbRows || m.nbCols != other.nbCols {
+		return false
+	}
+	for i := range m.data {
+		if m.data[i].Cmp(&other.data[i]) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Returns a new rational matrix that is the element-wise sum of m and other.
+//
+// Returns an error if the matrices do not have the same dimensions.
+func (m *RatMatrix) Add(other *RatMatrix) (*RatMatrix, error) {
+	if m.nbRows != other.nbRows || m.nbCols != other.nbCols {
+		return nil, fmt.Errorf("mismatch in the number of rows or columns between matrices, cannot perform addition")
+	}
+
+	result := NewRatMatrix(m.nbRows, m.nbCols)
+	for i := range m.data {
+		result.data[i].Add(&m.data[i], &other.data[i])
+	}
+
+	return result, nil
+}
+
+// Returns a new rational matrix that is the element-wise difference of m and other.
+//
+// Returns an error if the matrices do not have the same dimensions.
+func (m *RatMatrix) Sub(other *RatMatrix) (*RatMatrix, error) {
+	if m.nbRows != other.nbRows || m.nbCols != other.nbCols {
+		return nil, fmt.Errorf("mismatch in the number of rows or columns between matrices, cannot perform substraction")
+	}
+
+	result := NewRatMatrix(m.nbRows, m.nbCols)
+	for i := range m.data {
+		result.data[i].Sub(&m.data[i], &other.data[i])
+	}
+
+	return result, nil
+}
+
+// Returns a new rational matrix where each element of m is multiplied by the given scalar.
+func (m *RatMatrix) MulScalar(scalar *big.Rat) *RatMatrix {
+	result := NewRatMatrix(m.nbRows, m.nbCols)
+	for i := range m.data {
+		result.data[i].Mul(&m.data[i], scalar)
+	}
+
+	return result
+}
+
+// Returns the product of the rational matrix m and the rational matrix other.
+//
+// Returns an error if the number of columns of m does not match
+// the number of rows of other.
+func (m *RatMatrix) Mul(other *RatMatrix) (*RatMatrix, error) {
+	if m.nbCols != other.nbRows {
+		return nil, fmt.Errorf("mismatch between number of columns of first matrix (%d) and number of rows of second matrix (%d), cannot perform multiplication", m.nbCols, other.nbRows)
+	}
+
+	result := NewRatMatrix(m.nbRows, other.nbCols)
+	var product big.Rat
+	for i := 0; i < m.nbRows; i++ {
+		for k := 0; k < m.nbCols; k++ {
+			value := m.at(i, k)
+			if value.Sign() == 0 {
+				continue
+			}
+			for j := 0; j < other.nbCols; j++ {
+				product.Mul(value, other.at(k, j))
+				result.at(i, j).Add(result.at(i, j), &product)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// Returns the transpose of the rational matrix.
+func (m *RatMatrix) Transpose() *RatMatrix {
+	result := NewRatMatrix(m.nbCols, m.nbRows)
+	for i := 0; i < m.nbRows; i++ {
+		for j := 0; j < m.nbCols; j++ {
+			result.at(j, i).Set(m.at(i, j))
+		}
+	}
+
+	return result
+}
+
+// Swaps rows i and j of the rational matrix in place.
+func (m *RatMatrix) swapRows(i int, j int) {
+	if i == j {
+		return
+	}
+	var tmp big.Rat
+	for k := 0; k < m.nbCols; k++ {
+		tmp.Set(m.at(i, k))
+		m.at(i, k).Set(m.at(j, k))
+		m.at(j, k).Set(&tmp)
+	}
+}
+
+// Returns the exact determinant of the rational matrix.
+//
+// It uses the fraction-free Bareiss algorithm: every division is exact, and
+// for a matrix of integers all intermediate values are integers bounded by
+// minors of the matrix, which keeps their size under control.
+// Returns an error if the matrix is not square.
+// By convention, the determinant of an empty (0x0) matrix is zero.
+func (m *RatMatrix) Determinant() (*big.Rat, error) {
+	if !m.IsSquare() {
+		return nil, fmt.Errorf("cannot compute the determinant of a non-square matrix")
+	}
+	n := m.nbRows
+	if n == 0 {
+		return new(big.Rat), nil
+	}
+
+	a := m.Copy()
+	sign := 1
+	previous := big.NewRat(1, 1)
+	var left, right big.Rat
+	for k := 0; k < n-1; k++ {
+		// Any non-zero pivot works, no rounding error to control
+		if a.at(k, k).Sign() == 0 {
+			p := k + 1
+			for p < n && a.at(p, k).Sign() == 0 {
+				p++
+			}
+			if p == n {
+				return new(big.Rat), nil
+			}
+			a.swapRows(k, p)
+			sign = -sign
+		}
+		for i := k + 1; i < n; i++ {
+			for j := k + 1; j < n; j++ {
+				// a[i][j] = (a[k][k] * a[i][j] - a[i][k] * a[k][j]) / previous
+				left.Mul(a.at(k, k), a.at(i, j))
+				right.Mul(a.at(i, k), a.at(k, j))
+				a.at(i, j).Sub(&left, &right)
+				a.at(i, j).Quo(a.at(i, j), previous)
+			}
+		}
+		previous.Set(a.at(k, k))
+	}
+
+	determinant := new(big.Rat).Set(a.at(n-1, n-1))
+	if sign < 0 {
+		determinant.Neg(determinant)
+	}
+
+	return determinant, nil
+}
+
+// Returns the reduced row echelon form (RREF) of the rational matrix,
+// along with the indices of its pivot columns, in increasing order.
+//
+// Every pivot is 1 and is the only non-zero element of its column.
+// The computation is exact, and the original matrix is not modified.
+func (m *RatMatrix) RREF() (*RatMatrix, []int) {
+	r := m.Copy()
+	pivots := []int{}
+	var factor, product big.Rat
+	row := 0
+	for col := 0; col < r.nbCols && row < r.nbRows; col++ {
+		// Any non-zero element is an exact pivot
+		p := row
+		for p < r.nbRows && r.at(p, col).Sign() == 0 {
+			p++
+		}
+		if p == r.nbRows {
+			continue
+		}
+		r.swapRows(row, p)
+
+		// Normalize pivot row
+		factor.Inv(r.at(row, col))
+		for c := col; c < r.nbCols; c++ {
+			r.at(row, c).Mul(r.at(row, c), &factor)
+		}
+
+		// Eliminate all other rows
+		for i := 0; i < r.nbRows; i++ {
+			if i == row || r.at(i, col).Sign() == 0 {
+				continue
+			}
+			factor.Set(r.at(i, col))
+			for c := col; c < r.nbCols; c++ {
+				product.Mul(&factor, r.at(row, c))
+				r.at(i, c).Sub(r.at(i, c), &product)
+			}
+		}
+
+		pivots = append(pivots, col)
+		row++
+	}
+
+	return r, pivots
+}
+
+// Returns the exact rank of the rational matrix, namely the number of pivots of its RREF.
+func (m *RatMatrix) Rank() int {
+	_, pivots := m.RREF()
+	return len(pivots)
+}
+
+// Returns a basis of the null space (kernel) of the rational matrix, namely
+// of the vectors x such that A * x = 0, as the columns of a matrix.
+//
+// There is one basis vector per free variable of the RREF: it has a 1 for
+// this variable, a 0 for the other free variables, and the pivot variables
+// are set accordingly. The result has as many rows as the matrix has columns,
+// and no column if the matrix has full column rank.
+func (m *RatMatrix) NullSpace() *RatMatrix {
+	r, pivots := m.RREF()
+	isPivot := make([]bool, m.nbCols)
+	for _, col := range pivots {
+		isPivot[col] = true
+	}
+
+	basis := NewRatMatrix(m.nbCols, m.nbCols-len(pivots))
+	k := 0
+	for free := 0; free < m.nbCols; free++ {
+		if isPivot[free] {
+			continue
+		}
+		basis.at(free, k).SetInt64(1)
+		for i, col := range pivots {
+			basis.at(col, k).Neg(r.at(i, free))
+		}
+		k++
+	}
+
+	return basis
+}
+
+// Returns the exact inverse of the rational matrix, computed with
+// Gauss-Jordan elimination on [A | I].
+//
+// Returns an error if the matrix is not square, or an error wrapping
+// ErrSingular if it is singular. The original matrix is not modified.
+func (m *RatMatrix) Invert() (*RatMatrix, error) {
+	if !m.IsSquare() {
+		return nil, fmt.Errorf("matrix is not squared, it cannot be inverted")
+	}
+	n := m.nbRows
+	if n == 0 {
+		return nil, fmt.Errorf("%w, cannot invert", ErrSingular)
+	}
+
+	augmented := NewRatMatrix(n, 2*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			augmented.at(i, j).Set(m.at(i, j))
+		}
+		augmented.at(i, n+i).SetInt64(1)
+	}
+
+	r, pivots := augmented.RREF()
+	// The left block reduces to the identity if and only if A is invertible
+	if len(pivots) < n || pivots[n-1] != n-1 {
+		return nil, fmt.Errorf("%w, cannot invert", ErrSingular)
+	}
+
+	inv := NewRatMatrix(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			inv.at(i, j).Set(r.at(i, n+j))
+		}
+	}
+
+	return inv, nil
+}
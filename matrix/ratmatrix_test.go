@@ -0,0 +1,212 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRatMatrixFromData(t *testing.T) {
+	third := big.NewRat(1, 3)
+
+	m, err := NewRatMatrixFromData([][]*big.Rat{{third, big.NewRat(2, 1)}})
+	require.NoError(t, err)
+	third.SetInt64(5)
+
+	assert.Equal(t, 1, m.GetNbRows())
+	assert.Equal(t, 2, m.GetNbCols())
+	assert.Equal(t, "1/3", m.GetElementAt(0, 0).RatString())
+
+	_, err = NewRatMatrixFromData([][]*big.Rat{{third}, {}})
+	assert.Error(t, err)
+	_, err = NewRatMatrixFromData([][]*big.Rat{{nil}})
+	assert.Error(t, err)
+	_, err = NewRatMatrixFromInts([][]int64{{1, 2}, {3}})
+	assert.Error(t, err)
+}
+
+func TestRatMatrix_GetSetElementAt_Copies(t *testing.T) {
+	m := NewRatMatrix(1, 1)
+	value := big.NewRat(3, 4)
+
+	m.SetElementAt(0, 0, value)
+	value.SetInt64(0)
+	m.GetElementAt(0, 0).SetInt64(7)
+
+	assert.Equal(t, "3/4", m.GetElementAt(0, 0).RatString())
+}
+
+func TestRatMatrix_ElementAt_ShouldPanic_OutOfRange(t *testing.T) {
+	m := NewRatMatrix(2, 2)
+
+	assert.Panics(t, func() { m.SetElementAt(0, 2, big.NewRat(5, 1)) })
+	assert.Panics(t, func() { m.GetElementAt(-1, 0) })
+	assert.Equal(t, 0, m.GetElementAt(1, 0).Sign())
+}
+
+func TestRatMatrix_DenseRoundTrip(t *testing.T) {
+	dense, _ := NewFromData([][]float64{{0.5, -3}, {0.1, 1e-300}})
+
+	m, err := NewRatMatrixFromDense(dense)
+	require.NoError(t, err)
+
+	assert.Equal(t, "1/2", m.GetElementAt(0, 0).RatString())
+	assert.NotEqual(t, "1/10", m.GetElementAt(1, 0).RatString())
+	assert.True(t, m.ToDense().EqualsApprox(dense, 0))
+
+	_, err = NewRatMatrixFromDense(NewFromFlat(1, 1, []float64{math.NaN()}))
+	assert.Error(t, err)
+}
+
+func TestRatMatrix_String(t *testing.T) {
+	m, _ := NewRatMatrixFromData([][]*big.Rat{{big.NewRat(-1, 3), big.NewRat(2, 1)}})
+
+	assert.Equal(t, "[]", NewRatMatrix(0, 0).String())
+	assert.Equal(t, "[\n  [    -1/3,        2]\n]", m.String())
+}
+
+func TestRatMatrix_AddSubMul(t *testing.T) {
+	a, _ := NewRatMatrixFromInts([][]int64{{1, 2}, {3, 4}})
+	b, _ := NewRatMatrixFromInts([][]int64{{0, 1}, {1, 0}})
+
+	expectedSum, _ := NewRatMatrixFromInts([][]int64{{1, 3}, {4, 4}})
+	expectedDiff, _ := NewRatMatrixFromInts([][]int64{{1, 1}, {2, 4}})
+	expectedProduct, _ := NewRatMatrixFromInts([][]int64{{2, 1}, {4, 3}})
+	expectedTranspose, _ := NewRatMatrixFromInts([][]int64{{1, 3}, {2, 4}})
+	half, _ := NewRatMatrixFromData([][]*big.Rat{
+		{big.NewRat(1, 2), big.NewRat(1, 1)},
+		{big.NewRat(3, 2), big.NewRat(2, 1)},
+	})
+
+	sum, err := a.Add(b)
+	require.NoError(t, err)
+	diff, err := a.Sub(b)
+	require.NoError(t, err)
+	product, err := a.Mul(b)
+	require.NoError(t, err)
+
+	assert.True(t, sum.Equals(expectedSum))
+	assert.True(t, diff.Equals(expectedDiff))
+	assert.True(t, product.Equals(expectedProduct))
+	assert.True(t, a.MulScalar(big.NewRat(1, 2)).Equals(half))
+	assert.True(t, a.Transpose().Equals(expectedTranspose))
+
+	_, err = a.Add(NewRatMatrix(1, 2))
+	assert.Error(t, err)
+	_, err = a.Sub(NewRatMatrix(1, 2))
+	assert.Error(t, err)
+	_, err = a.Mul(NewRatMatrix(3, 1))
+	assert.Error(t, err)
+}
+
+func TestRatMatrix_Determinant(t *testing.T) {
+	m, _ := NewRatMatrixFromInts([][]int64{
+		{0, 2, 1},
+		{1, 1, 1},
+		{2, 1, 3},
+	})
+	singular, _ := NewRatMatrixFromInts([][]int64{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	})
+
+	det, err := m.Determinant()
+	require.NoError(t, err)
+	zero, err := singular.Determinant()
+	require.NoError(t, err)
+	empty, err := NewRatMatrix(0, 0).Determinant()
+	require.NoError(t, err)
+
+	assert.Equal(t, "-3", det.RatString())
+	assert.Equal(t, 0, zero.Sign())
+	assert.Equal(t, 0, empty.Sign())
+	_, err = NewRatMatrix(2, 3).Determinant()
+	assert.Error(t, err)
+}
+
+func TestRatMatrix_Determinant_Hilbert(t *testing.T) {
+	// Notoriously ill-conditioned, its determinant is 1/n-th of a product of known integers
+	n := 6
+	data := make([][]*big.Rat, n)
+	for i := range data {
+		data[i] = make([]*big.Rat, n)
+		for j := range data[i] {
+			data[i][j] = big.NewRat(1, int64(i+j+1))
+		}
+	}
+	hilbert, err := NewRatMatrixFromData(data)
+	require.NoError(t, err)
+
+	det, err := hilbert.Determinant()
+	require.NoError(t, err)
+	inv, err := hilbert.Invert()
+	require.NoError(t, err)
+	product, err := hilbert.Mul(inv)
+	require.NoError(t, err)
+
+	assert.Equal(t, "1/186313420339200000", det.RatString())
+	assert.True(t, product.Equals(NewRatIdentity(n)))
+	// The inverse of a Hilbert matrix has integer elements
+	assert.True(t, inv.GetElementAt(5, 5).IsInt())
+}
+
+func TestRatMatrix_Invert_ShouldFail(t *testing.T) {
+	singular, _ := NewRatMatrixFromInts([][]int64{{1, 2}, {2, 4}})
+
+	_, err := singular.Invert()
+	assert.True(t, errors.Is(err, ErrSingular))
+	_, err = NewRatMatrix(0, 0).Invert()
+	assert.True(t, errors.Is(err, ErrSingular))
+	_, err = NewRatMatrix(2, 3).Invert()
+	assert.Error(t, err)
+}
+
+func TestRatMatrix_RREF(t *testing.T) {
+	m, _ := NewRatMatrixFromInts([][]int64{
+		{1, 2, 1, 4},
+		{2, 4, 0, 2},
+		{3, 6, 1, 6},
+	})
+	expected, _ := NewRatMatrixFromInts([][]int64{
+		{1, 2, 0, 1},
+		{0, 0, 1, 3},
+		{0, 0, 0, 0},
+	})
+
+	r, pivots := m.RREF()
+
+	assert.True(t, r.Equals(expected), "got %v", r)
+	assert.Equal(t, []int{0, 2}, pivots)
+	assert.Equal(t, 2, m.Rank())
+	assert.Equal(t, 0, NewRatMatrix(2, 2).Rank())
+}
+
+func TestRatMatrix_NullSpace(t *testing.T) {
+	m, _ := NewRatMatrixFromInts([][]int64{
+		{1, 2, 1, 4},
+		{2, 4, 0, 2},
+		{3, 6, 1, 6},
+	})
+
+	expected, _ := NewRatMatrixFromInts([][]int64{
+		{-2, -1},
+		{1, 0},
+		{0, -3},
+		{0, 1},
+	})
+
+	basis := m.NullSpace()
+	product, err := m.Mul(basis)
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, basis.GetNbRows())
+	assert.Equal(t, 2, basis.GetNbCols())
+	assert.True(t, product.Equals(NewRatMatrix(3, 2)))
+	assert.True(t, basis.Equals(expected))
+	assert.Equal(t, 0, NewRatIdentity(3).NullSpace().GetNbCols())
+}
@@ -0,0 +1,204 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/JoLandry/linalgo/vector"
+)
+
+// Errors returned when solving linear systems.
+//
+// They are wrapped with some context, use errors.Is to check for them.
+var (
+	// The matrix of a square system is singular
+	ErrSingular = errors.New("matrix is singular")
+	// The system has no solution
+	ErrInconsistent = errors.New("system is inconsistent")
+)
+
+// Solves the linear system A * x = b, where A is the calling matrix.
+//
+// Square systems are solved with the LU factorization with partial pivoting,
+// and an error wrapping ErrSingular is returned if A is singular.
+// Rectangular systems are solved with Gauss-Jordan elimination with partial pivoting:
+//   - over-determined systems return the solution if the equations agree
+//   - under-determined systems return a basic solution, with all free variables set to zero
+//
+// An error wrapping ErrInconsistent is returned if a rectangular system has no solution.
+// Returns an error if b does not have as many elements as A has rows.
+func (m *MatrixOf[T]) Solve(b *vector.VectorOf[T]) (*vector.VectorOf[T], error) {
+	if b.GetSize() != m.nbRows {
+		return nil, fmt.Errorf("mismatch between number of rows of matrix (%d) and size of right-hand side (%d)", m.nbRows, b.GetSize())
+	}
+
+	x, err := m.SolveMatrix(NewFromFlatOf(m.nbRows, 1, b.GetData()))
+	if err != nil {
+		return nil, err
+	}
+
+	return x.colToVector(0), nil
+}
+
+// Solves the linear system A * X = B, where A is the calling matrix
+// and each column of B is a right-hand side.
+//
+// It follows the same rules as Solve for square, over-determined
+// and under-determined systems. The systems are solved in float64, with
+// pivot thresholds scaled to the precision of T.
+// Returns an error if B does not have as many rows as A.
+func (m *MatrixOf[T]) SolveMatrix(b *MatrixOf[T]) (*MatrixOf[T], error) {
+	if b.nbRows != m.nbRows {
+		return nil, fmt.Errorf("mismatch between number of rows of matrix (%d) and number of rows of right-hand side (%d)", m.nbRows, b.nbRows)
+	}
+	if m.IsSquare() && m.nbRows > 0 {
+		lu, err := m.LU()
+		if err != nil {
+			return nil, err
+		}
+		x, err := lu.SolveMatrix(float64Of(b))
+		if err != nil {
+			return nil, err
+		}
+		return fromFloat64[T](x), nil
+	}
+
+	x, err := solveRectangular(float64Of(m), float64Of(b), vector.Tolerance[T](pivotTolerance))
+	if err != nil {
+		return nil, err
+	}
+
+	return fromFloat64[T](x), nil
+}
+
+// Solves A * x = b with the LU factorization, where A is the factorized matrix.
+//
+// Returns an error if b does not have as many elements as A has rows,
+// or an error wrapping ErrSingular if A is singular.
+func (f *LU) Solve(b *vector.Vector) (*vector.Vector, error) {
+	n := f.lu.nbRows
+	if b.GetSize() != n {
+		return nil, fmt.Errorf("mismatch between size of factorized matrix (%d) and size of right-hand side (%d)", n, b.GetSize())
+	}
+
+	x, err := f.SolveMatrix(NewFromFlat(n, 1, b.GetData()))
+	if err != nil {
+		return nil, err
+	}
+
+	return x.colToVector(0), nil
+}
+
+// Solves a (possibly) rectangular system A * X = B with Gauss-Jordan elimination
+// and partial pivoting on the augmented matrix [A | B], where pivots not greater
+// than tolerance are considered to be zero.
+//
+// This helper function assumes B has as many rows as A.
+func solveRectangular(m *Matrix, b *Matrix, tolerance float64) (*Matrix, error) {
+	a := m.Copy()
+	rhs := b.Copy()
+	pivotCols := gaussJordan(a, rhs, tolerance)
+	row := len(pivotCols)
+
+	// Remaining equations read 0 = rhs, which must hold
+	scale := math.Max(1.0, b.maxAbs())
+	for r := row; r < rhs.nbRows; r++ {
+		for c := 0; c < rhs.nbCols; c++ {
+			if math.Abs(rhs.row(r)[c]) > tolerance*scale {
+				return nil, fmt.Errorf("%w: equation %d cannot be satisfied", ErrInconsistent, r)
+			}
+		}
+	}
+
+	x := New(m.nbCols, b.nbCols)
+	for r, col := range pivotCols {
+		copy(x.row(col), rhs.row(r))
+	}
+
+	return x, nil
+}
+
+// Reduces a to its reduced row echelon form in place with Gauss-Jordan elimination
+// and partial pivoting, applying the same row operations to rhs.
+// Candidate pivots whose absolute value is not greater than tolerance are considered
+// to be zero, and the corresponding columns are skipped.
+//
+// Returns the indices of the pivot columns, in increasing order.
+// This helper function assumes rhs has as many rows as a.
+func gaussJordan(a *Matrix, rhs *Matrix, tolerance float64) []int {
+	pivotCols := []int{}
+	row := 0
+	for col := 0; col < a.nbCols && row < a.nbRows; col++ {
+		// Find pivot element (max absolute value in column col at or below row)
+		pivot := row
+		maxVal := math.Abs(a.row(row)[col])
+		for r := row + 1; r < a.nbRows; r++ {
+			if math.Abs(a.row(r)[col]) > maxVal {
+				maxVal = math.Abs(a.row(r)[col])
+				pivot = r
+			}
+		}
+		// Free variable
+		if maxVal <= tolerance {
+			continue
+		}
+		if pivot != row {
+			a.swapRows(row, pivot)
+			rhs.swapRows(row, pivot)
+		}
+
+		// Normalize pivot row
+		pivotVal := a.row(row)[col]
+		for c := col; c < a.nbCols; c++ {
+			a.row(row)[c] /= pivotVal
+		}
+		for c := 0; c < rhs.nbCols; c++ {
+			rhs.row(row)[c] /= pivotVal
+		}
+
+		// Eliminate all other rows
+		for r := 0; r < a.nbRows; r++ {
+			if r == row {
+				continue
+			}
+			factor := a.row(r)[col]
+			if factor == 0.0 {
+				continue
+			}
+			for c := col; c < a.nbCols; c++ {
+				a.row(r)[c] -= factor * a.row(row)[c]
+			}
+			for c := 0; c < rhs.nbCols; c++ {
+				rhs.row(r)[c] -= factor * rhs.row(row)[c]
+			}
+		}
+
+		pivotCols = append(pivotCols, col)
+		row++
+	}
+
+	return pivotCols
+}
+
+// Returns the largest absolute value among the elements of the matrix.
+func (m *MatrixOf[T]) maxAbs() float64 {
+	maxVal := 0.0
+	for i := 0; i < m.nbRows; i++ {
+		for j := 0; j < m.nbCols; j++ {
+			maxVal = math.Max(maxVal, math.Abs(float64(m.row(i)[j])))
+		}
+	}
+
+	return maxVal
+}
+
+// Returns a copy of the column col of the matrix as a vector.
+func (m *MatrixOf[T]) colToVector(col int) *vector.VectorOf[T] {
+	result := vector.NewOf[T](m.nbRows)
+	for i := 0; i < m.nbRows; i++ {
+		result.SetElementAt(i, m.row(i)[col])
+	}
+
+	return result
+}
@@ -0,0 +1,135 @@
+package matrix
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/JoLandry/linalgo/vector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSolve_SquareSystem(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{2, 1, -1},
+		{-3, -1, 2},
+		{-2, 1, 2},
+	})
+	b := vector.NewFromData([]float64{8, -11, -3})
+
+	x, err := m.Solve(b)
+
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{2, 3, -1}, x.GetData(), 1e-9)
+}
+
+func TestSolve_ShouldFail_Singular(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{1, 2},
+		{2, 4},
+	})
+	b := vector.NewFromData([]float64{1, 2})
+
+	_, err := m.Solve(b)
+
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, ErrSingular))
+}
+
+func TestSolve_ShouldFail_DimensionMismatch(t *testing.T) {
+	m := NewIdentity(3)
+	b := vector.NewFromData([]float64{1, 2})
+
+	_, err := m.Solve(b)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "mismatch")
+}
+
+func TestSolve_OverDeterminedConsistent(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{1, 1},
+		{1, -1},
+		{2, 1},
+	})
+	b := vector.NewFromData([]float64{3, 1, 5})
+
+	x, err := m.Solve(b)
+
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{2, 1}, x.GetData(), 1e-9)
+}
+
+func TestSolve_OverDeterminedInconsistent(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{1, 1},
+		{1, -1},
+		{2, 1},
+	})
+	b := vector.NewFromData([]float64{3, 1, 6})
+
+	_, err := m.Solve(b)
+
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, ErrInconsistent))
+}
+
+func TestSolve_UnderDetermined(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{1, 2, 3},
+		{0, 1, 1},
+	})
+	b := vector.NewFromData([]float64{6, 2})
+
+	x, err := m.Solve(b)
+	require.NoError(t, err)
+
+	// Any solution is fine as long as it satisfies the system
+	product, err := m.MulVec(x)
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, b.GetData(), product.GetData(), 1e-9)
+	assert.Equal(t, 3, x.GetSize())
+}
+
+func TestSolveMatrix_MultipleRightHandSides(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{3, 2},
+		{1, 2},
+	})
+	b, _ := NewFromData([][]float64{
+		{5, 1, 0},
+		{3, 0, 1},
+	})
+
+	x, err := m.SolveMatrix(b)
+	require.NoError(t, err)
+
+	product, _ := m.Mul(x)
+	assert.True(t, product.EqualsApprox(b, 1e-9), "expected %v, got %v", b, product)
+}
+
+func TestSolveMatrix_ShouldFail_DimensionMismatch(t *testing.T) {
+	_, err := NewIdentity(2).SolveMatrix(New(3, 2))
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "mismatch")
+}
+
+func TestLU_Solve_ReusedFactorization(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{4, -2, 1},
+		{-2, 4, -2},
+		{1, -2, 4},
+	})
+	lu, err := m.LU()
+	require.NoError(t, err)
+
+	for _, values := range [][]float64{{11, -16, 17}, {1, 0, 0}, {0, 0, 3}} {
+		b := vector.NewFromData(values)
+		x, err := lu.Solve(b)
+		require.NoError(t, err)
+
+		product, _ := m.MulVec(x)
+		assert.InDeltaSlice(t, values, product.GetData(), 1e-9)
+	}
+}
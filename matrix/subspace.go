@@ -0,0 +1,121 @@
+package matrix
+
+import (
+	"github.com/JoLandry/linalgo/vector"
+)
+
+// Returns the Reduced Row Echelon Form (RREF) of the matrix, along with
+// the indices of its pivot columns, in increasing order.
+//
+// The RREF is computed in float64 with Gauss-Jordan elimination and partial pivoting.
+// Candidate pivots whose absolute value is not greater than tol are considered
+// to be zero. If tol is not positive, the default tolerance max(m, n) * eps * max|a_ij|
+// is used, where eps is the machine epsilon of T.
+// The rows below the last pivot row are set to zero, so that the number of pivot
+// columns is the rank of the matrix for this tolerance.
+// The original matrix is not modified.
+func (m *MatrixOf[T]) RREF(tol float64) (*MatrixOf[T], []int) {
+	if tol <= 0 {
+		tol = float64(max(m.nbRows, m.nbCols)) * vector.Epsilon[T]() * m.maxAbs()
+	}
+
+	rref := float64Of(m).Copy()
+	pivotCols := gaussJordan(rref, New(m.nbRows, 0), tol)
+	for i := len(pivotCols); i < rref.nbRows; i++ {
+		clear(rref.row(i))
+	}
+
+	return fromFloat64[T](rref), pivotCols
+}
+
+// Returns a basis of the null space (kernel) of the matrix, namely the
+// vectors x such that A * x = 0, as the columns of a n x (n - r) matrix,
+// where n is the number of columns of A and r its rank.
+//
+// The basis is computed from the RREF with the given tolerance (see RREF):
+// there is one basis vector per free column, holding 1 for this column.
+// Use Columns to get the basis as a slice of vectors.
+func (m *MatrixOf[T]) NullSpace(tol float64) *MatrixOf[T] {
+	rref, pivotCols := m.RREF(tol)
+	freeCols := nonPivotColumns(m.nbCols, pivotCols)
+
+	basis := NewOf[T](m.nbCols, len(freeCols))
+	for k, free := range freeCols {
+		basis.row(free)[k] = 1.0
+		for i, col := range pivotCols {
+			basis.row(col)[k] = -rref.row(i)[free]
+		}
+	}
+
+	return basis
+}
+
+// Returns a basis of the column space (range) of the matrix, as the
+// columns of a m x r matrix, where m is the number of rows of A and r its rank.
+//
+// The basis is made of the columns of A matching the pivot columns of its RREF,
+// computed with the given tolerance (see RREF).
+// Use Columns to get the basis as a slice of vectors.
+func (m *MatrixOf[T]) ColumnSpace(tol float64) *MatrixOf[T] {
+	_, pivotCols := m.RREF(tol)
+
+	basis := NewOf[T](m.nbRows, len(pivotCols))
+	for i := 0; i < m.nbRows; i++ {
+		for k, col := range pivotCols {
+			basis.row(i)[k] = m.row(i)[col]
+		}
+	}
+
+	return basis
+}
+
+// Returns a basis of the row space of the matrix, as the columns of a
+// n x r matrix, where n is the number of columns of A and r its rank.
+//
+// The basis is made of the non-zero rows of the RREF of A, computed with
+// the given tolerance (see RREF).
+// Use Columns to get the basis as a slice of vectors.
+func (m *MatrixOf[T]) RowSpace(tol float64) *MatrixOf[T] {
+	rref, pivotCols := m.RREF(tol)
+
+	basis := NewOf[T](m.nbCols, len(pivotCols))
+	for k := range pivotCols {
+		for j := 0; j < m.nbCols; j++ {
+			basis.row(j)[k] = rref.row(k)[j]
+		}
+	}
+
+	return basis
+}
+
+// Returns a basis of the left null space of the matrix, namely the
+// vectors y such that A^T * y = 0, as the columns of a m x (m - r) matrix,
+// where m is the number of rows of A and r its rank.
+//
+// It is the null space of the transpose of A, computed with the given tolerance (see RREF).
+// Use Columns to get the basis as a slice of vectors.
+func (m *MatrixOf[T]) LeftNullSpace(tol float64) *MatrixOf[T] {
+	// Transpose does not keep the shape of empty matrices
+	if m.nbCols == 0 {
+		return NewIdentityOf[T](m.nbRows)
+	}
+
+	return m.Transpose().NullSpace(tol)
+}
+
+// Returns the indices in [0, n) that are not pivot columns.
+//
+// This helper function assumes pivotCols is sorted in increasing order.
+func nonPivotColumns(n int, pivotCols []int) []int {
+	freeCols := make([]int, 0, n-len(pivotCols))
+	next := 0
+	for col := 0; col < n; col++ {
+		if next < len(pivotCols) && pivotCols[next] == col {
+			next++
+			continue
+		}
+		freeCols = append(freeCols, col)
+	}
+
+	return freeCols
+}
@@ -0,0 +1,129 @@
+package matrix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Rank 2 matrix with pivot columns 0 and 2
+func newTestRankDeficient(t *testing.T) *Matrix {
+	m, err := NewFromData([][]float64{
+		{1, 2, 1, 4},
+		{2, 4, 0, 2},
+		{3, 6, 1, 6},
+	})
+	require.NoError(t, err)
+	return m
+}
+
+func TestRREF(t *testing.T) {
+	m := newTestRankDeficient(t)
+	expected, _ := NewFromData([][]float64{
+		{1, 2, 0, 1},
+		{0, 0, 1, 3},
+		{0, 0, 0, 0},
+	})
+
+	rref, pivots := m.RREF(0)
+
+	assert.True(t, rref.EqualsApprox(expected, 1e-12), "got %v", rref)
+	assert.Equal(t, []int{0, 2}, pivots)
+	// Original matrix is not modified
+	assert.True(t, m.EqualsApprox(newTestRankDeficient(t), 0))
+}
+
+func TestRREF_Tolerance(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{1, 1},
+		{1, 1 + 1e-9},
+	})
+
+	_, pivots := m.RREF(0)
+	_, loosePivots := m.RREF(1e-6)
+
+	assert.Equal(t, []int{0, 1}, pivots)
+	assert.Equal(t, []int{0}, loosePivots)
+}
+
+func TestRREF_Empty(t *testing.T) {
+	rref, pivots := New(0, 3).RREF(0)
+	zero, zeroPivots := New(2, 2).RREF(0)
+
+	assert.Equal(t, 0, rref.GetNbRows())
+	assert.Empty(t, pivots)
+	assert.True(t, zero.IsZero())
+	assert.Empty(t, zeroPivots)
+}
+
+func TestNullSpace(t *testing.T) {
+	m := newTestRankDeficient(t)
+	expected, _ := NewFromData([][]float64{
+		{-2, -1},
+		{1, 0},
+		{0, -3},
+		{0, 1},
+	})
+
+	basis := m.NullSpace(0)
+	product, err := m.Mul(basis)
+	require.NoError(t, err)
+
+	assert.True(t, basis.EqualsApprox(expected, 1e-12), "got %v", basis)
+	assert.True(t, product.EqualsApprox(New(3, 2), 1e-12))
+	assert.Equal(t, 0, NewIdentity(3).NullSpace(0).GetNbCols())
+	assert.True(t, New(2, 3).NullSpace(0).IsIdentity())
+}
+
+func TestColumnSpace(t *testing.T) {
+	m := newTestRankDeficient(t)
+	expected, _ := NewFromData([][]float64{
+		{1, 1},
+		{2, 0},
+		{3, 1},
+	})
+
+	basis := m.ColumnSpace(0)
+
+	assert.True(t, basis.EqualsApprox(expected, 0))
+	assert.Len(t, basis.Columns(), m.Rank())
+}
+
+func TestRowSpace(t *testing.T) {
+	m := newTestRankDeficient(t)
+
+	basis := m.RowSpace(0)
+	// Row space is orthogonal to the null space
+	product, err := m.NullSpace(0).Transpose().Mul(basis)
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, basis.GetNbRows())
+	assert.Equal(t, 2, basis.GetNbCols())
+	assert.True(t, product.EqualsApprox(New(2, 2), 1e-12))
+}
+
+func TestLeftNullSpace(t *testing.T) {
+	m := newTestRankDeficient(t)
+
+	basis := m.LeftNullSpace(0)
+	product, err := m.Transpose().Mul(basis)
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, basis.GetNbRows())
+	assert.Equal(t, 1, basis.GetNbCols())
+	assert.True(t, product.EqualsApprox(New(4, 1), 1e-12))
+	assert.True(t, New(3, 0).LeftNullSpace(0).IsIdentity())
+}
+
+func TestSubspaces_Float32(t *testing.T) {
+	m := Convert[float32](newTestRankDeficient(t))
+
+	_, pivots := m.RREF(0)
+	basis := m.NullSpace(0)
+	product, err := m.Mul(basis)
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{0, 2}, pivots)
+	assert.True(t, product.EqualsApprox(NewOf[float32](3, 2), 1e-5))
+}
@@ -0,0 +1,327 @@
+package matrix
+
+import (
+	"math"
+	"sort"
+
+	"github.com/JoLandry/linalgo/vector"
+)
+
+// Maximum number of sweeps of the Jacobi algorithms (SVD and symmetric eigenvalues).
+const maxJacobiSweeps = 100
+
+// SVD holds the singular value decomposition of a m x n matrix.
+//
+// The decomposition satisfies A = U * Σ * V^T, where U and V have orthonormal
+// columns and Σ is diagonal with non-negative, non-increasing values.
+// With k = min(m, n), the thin form has U of size m x k, Σ of size k x k and
+// V of size n x k, while the full form has U of size m x m, Σ of size m x n
+// and V of size n x n.
+type SVD struct {
+	u      *Matrix
+	values []float64
+	v      *Matrix
+	nbRows int
+	nbCols int
+	// Machine epsilon of the elements of A, used by Rank
+	epsilon float64
+}
+
+// Computes and returns the thin singular value decomposition of the matrix.
+//
+// It uses the one-sided Jacobi algorithm, which is slower than Golub-Kahan
+// bidiagonalization but computes small singular values to high relative accuracy.
+// The decomposition is computed in float64. The original matrix is not modified.
+func (m *MatrixOf[T]) SVD() *SVD {
+	return jacobiSVD(float64Of(m), false, vector.Epsilon[T]())
+}
+
+// Computes and returns the full singular value decomposition of the matrix.
+//
+// Same as SVD, except U and V are completed into square orthogonal matrices.
+// The original matrix is not modified.
+func (m *MatrixOf[T]) SVDFull() *SVD {
+	return jacobiSVD(float64Of(m), true, vector.Epsilon[T]())
+}
+
+// Computes the singular value decomposition of the matrix, in thin or full form,
+// for a matrix whose elements have the given machine epsilon.
+func jacobiSVD(m *Matrix, full bool, epsilon float64) *SVD {
+	// Work on the transpose of wide matrices: A^T = U * Σ * V^T gives A = V * Σ * U^T
+	if m.nbRows < m.nbCols {
+		svd := jacobiSVD(m.Transpose(), full, epsilon)
+		return &SVD{u: svd.v, values: svd.values, v: svd.u, nbRows: m.nbRows, nbCols: m.nbCols, epsilon: epsilon}
+	}
+
+	nbRows, nbCols := m.nbRows, m.nbCols
+	u := m.Copy()
+	v := NewIdentity(nbCols)
+
+	// Rotate pairs of columns until they are all orthogonal to each other
+	for sweep := 0; sweep < maxJacobiSweeps; sweep++ {
+		rotated := false
+		for p := 0; p < nbCols-1; p++ {
+			for q := p + 1; q < nbCols; q++ {
+				alpha, beta, gamma := 0.0, 0.0, 0.0
+				for i := 0; i < nbRows; i++ {
+					alpha += u.row(i)[p] * u.row(i)[p]
+					beta += u.row(i)[q] * u.row(i)[q]
+					gamma += u.row(i)[p] * u.row(i)[q]
+				}
+				if gamma == 0.0 || math.Abs(gamma) <= machineEpsilon*math.Sqrt(alpha*beta) {
+					continue
+				}
+				rotated = true
+
+				zeta := (beta - alpha) / (2.0 * gamma)
+				t := 1.0 / (math.Abs(zeta) + math.Sqrt(1.0+zeta*zeta))
+				if zeta < 0 {
+					t = -t
+				}
+				c := 1.0 / math.Sqrt(1.0+t*t)
+				s := c * t
+				rotateColumns(u, p, q, c, s)
+				rotateColumns(v, p, q, c, s)
+			}
+		}
+		if !rotated {
+			break
+		}
+	}
+
+	// Singular values are the norms of the columns
+	values := make([]float64, nbCols)
+	for j := 0; j < nbCols; j++ {
+		norm := 0.0
+		for i := 0; i < nbRows; i++ {
+			norm = math.Hypot(norm, u.row(i)[j])
+		}
+		values[j] = norm
+		if norm != 0.0 {
+			for i := 0; i < nbRows; i++ {
+				u.row(i)[j] /= norm
+			}
+		}
+	}
+
+	// Sort by non-increasing singular value
+	order := make([]int, nbCols)
+	for j := range order {
+		order[j] = j
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return values[order[a]] > values[order[b]]
+	})
+	sortedValues := make([]float64, nbCols)
+	sortedU := New(nbRows, nbCols)
+	sortedV := New(nbCols, nbCols)
+	for j, col := range order {
+		sortedValues[j] = values[col]
+		for i := 0; i < nbRows; i++ {
+			sortedU.row(i)[j] = u.row(i)[col]
+		}
+		for i := 0; i < nbCols; i++ {
+			sortedV.row(i)[j] = v.row(i)[col]
+		}
+	}
+
+	// Columns of U matching a zero singular value are zero, replace them
+	tolerance := float64(nbRows) * machineEpsilon * maxValue(sortedValues)
+	nbValid := 0
+	for nbValid < nbCols && sortedValues[nbValid] > tolerance {
+		nbValid++
+	}
+	width := nbCols
+	if full {
+		width = nbRows
+	}
+
+	return &SVD{
+		u:       completeOrthonormal(sortedU, nbValid, width),
+		values:  sortedValues,
+		v:       sortedV,
+		nbRows:  nbRows,
+		nbCols:  nbCols,
+		epsilon: epsilon,
+	}
+}
+
+// Applies the Jacobi rotation of parameters (c, s) to the columns p and q of m.
+func rotateColumns(m *Matrix, p int, q int, c float64, s float64) {
+	for i := 0; i < m.nbRows; i++ {
+		mp := m.row(i)[p]
+		mq := m.row(i)[q]
+		m.row(i)[p] = c*mp - s*mq
+		m.row(i)[q] = s*mp + c*mq
+	}
+}
+
+// Returns a matrix with width orthonormal columns, whose first nbValid
+// columns are those of m.
+//
+// The other columns are built by orthogonalizing vectors of the canonical
+// basis against the columns already accepted (Gram-Schmidt, run twice).
+// This helper assumes the first nbValid columns of m are orthonormal.
+func completeOrthonormal(m *Matrix, nbValid int, width int) *Matrix {
+	result := New(m.nbRows, width)
+	for i := 0; i < m.nbRows; i++ {
+		for j := 0; j < nbValid; j++ {
+			result.row(i)[j] = m.row(i)[j]
+		}
+	}
+
+	col := nbValid
+	candidate := make([]float64, m.nbRows)
+	for e := 0; e < m.nbRows && col < width; e++ {
+		for i := range candidate {
+			candidate[i] = 0.0
+		}
+		candidate[e] = 1.0
+		for pass := 0; pass < 2; pass++ {
+			for j := 0; j < col; j++ {
+				dot := 0.0
+				for i := 0; i < m.nbRows; i++ {
+					dot += result.row(i)[j] * candidate[i]
+				}
+				for i := 0; i < m.nbRows; i++ {
+					candidate[i] -= dot * result.row(i)[j]
+				}
+			}
+		}
+		norm := 0.0
+		for _, val := range candidate {
+			norm = math.Hypot(norm, val)
+		}
+		// Candidate (almost) in the span of the accepted columns
+		if norm < 0.5 {
+			continue
+		}
+		for i := 0; i < m.nbRows; i++ {
+			result.row(i)[col] = candidate[i] / norm
+		}
+		col++
+	}
+
+	return result
+}
+
+// Returns the largest value of a slice of non-negative values, or zero if it is empty.
+func maxValue(values []float64) float64 {
+	largest := 0.0
+	for _, val := range values {
+		largest = math.Max(largest, val)
+	}
+
+	return largest
+}
+
+// Returns the left singular vectors U, as columns.
+func (s *SVD) U() *Matrix {
+	return s.u.Copy()
+}
+
+// Returns the diagonal matrix Σ of singular values.
+//
+// Its size is k x k for the thin form and m x n for the full form.
+func (s *SVD) Sigma() *Matrix {
+	sigma := New(s.u.nbCols, s.v.nbCols)
+	for i, val := range s.values {
+		sigma.row(i)[i] = val
+	}
+
+	return sigma
+}
+
+// Returns the transpose of the right singular vectors V.
+func (s *SVD) VT() *Matrix {
+	return s.v.Transpose()
+}
+
+// Returns the singular values, sorted in non-increasing order.
+//
+// The returned slice is a copy.
+func (s *SVD) Values() []float64 {
+	values := make([]float64, len(s.values))
+	copy(values, s.values)
+
+	return values
+}
+
+// Returns the number of singular values greater than tol.
+//
+// If tol is not positive, the default tolerance max(m, n) * eps * σ_max
+// is used, where eps is the machine epsilon of the elements of the decomposed matrix.
+func (s *SVD) Rank(tol float64) int {
+	if tol <= 0 {
+		tol = float64(max(s.nbRows, s.nbCols)) * s.epsilon * maxValue(s.values)
+	}
+
+	rank := 0
+	for _, val := range s.values {
+		if val > tol {
+			rank++
+		}
+	}
+
+	return rank
+}
+
+// Returns the Moore-Penrose pseudo-inverse of the matrix, computed from
+// its singular value decomposition as V * Σ⁺ * U^T.
+//
+// Singular values below the default tolerance of SVD.Rank are treated as zero.
+// It is defined for any m x n matrix, and is equal to the inverse
+// for invertible matrices. The result is a n x m matrix.
+func (m *MatrixOf[T]) PseudoInverse() *MatrixOf[T] {
+	svd := m.SVD()
+	rank := svd.Rank(0)
+
+	result := New(m.nbCols, m.nbRows)
+	for i := 0; i < m.nbCols; i++ {
+		for j := 0; j < m.nbRows; j++ {
+			value := 0.0
+			for k := 0; k < rank; k++ {
+				value += svd.v.row(i)[k] * svd.u.row(j)[k] / svd.values[k]
+			}
+			result.row(i)[j] = value
+		}
+	}
+
+	return fromFloat64[T](result)
+}
+
+// Returns the spectral norm (2-norm) of the matrix, namely its largest singular value.
+//
+// By convention, the norm of an empty matrix is zero.
+func (m *MatrixOf[T]) Norm2() T {
+	return T(maxValue(m.SVD().values))
+}
+
+// Returns the 2-norm condition number of the matrix, namely the ratio
+// of its largest to its smallest singular value.
+//
+// It returns +Inf for numerically rank-deficient matrices, namely when the
+// smallest singular value is not greater than the default tolerance of SVD.Rank,
+// max(m, n) * eps * σ_max.
+// By convention, the condition number of an empty matrix is zero.
+func (m *MatrixOf[T]) cond2() float64 {
+	svd := m.SVD()
+	values := svd.values
+	if len(values) == 0 {
+		return 0.0
+	}
+	smallest := values[len(values)-1]
+	if smallest <= float64(max(svd.nbRows, svd.nbCols))*svd.epsilon*values[0] {
+		return math.Inf(1)
+	}
+
+	return values[0] / smallest
+}
+
+// Returns the numerical rank of the matrix, namely the number of singular values greater than tol.
+//
+// If tol is not positive, the default tolerance max(m, n) * eps * σ_max is
+// used, where eps is the machine epsilon of T.
+func (m *MatrixOf[T]) NumericalRank(tol float64) int {
+	return m.SVD().Rank(tol)
+}
@@ -0,0 +1,142 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Rebuilds U * Σ * V^T from a decomposition
+func reconstructSVD(t *testing.T, svd *SVD) *Matrix {
+	us, err := svd.U().Mul(svd.Sigma())
+	require.NoError(t, err)
+	usvt, err := us.Mul(svd.VT())
+	require.NoError(t, err)
+
+	return usvt
+}
+
+func TestSVD_ThinReconstructsTallMatrix(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{1, 2},
+		{3, 4},
+		{5, 6},
+	})
+
+	svd := m.SVD()
+
+	assert.Equal(t, 3, svd.U().nbRows)
+	assert.Equal(t, 2, svd.U().nbCols)
+	assert.Equal(t, 2, svd.Sigma().nbRows)
+	assert.Equal(t, 2, svd.VT().nbRows)
+	assert.True(t, reconstructSVD(t, svd).EqualsApprox(m, 1e-9))
+}
+
+func TestSVD_FullReconstructsWideMatrix(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{3, 2, 2},
+		{2, 3, -2},
+	})
+
+	svd := m.SVDFull()
+	u := svd.U()
+	vt := svd.VT()
+
+	assert.Equal(t, 2, u.nbCols)
+	assert.Equal(t, 2, svd.Sigma().nbRows)
+	assert.Equal(t, 3, svd.Sigma().nbCols)
+	assert.Equal(t, 3, vt.nbRows)
+	assert.InDeltaSlice(t, []float64{5, 3}, svd.Values(), 1e-9)
+	assert.True(t, reconstructSVD(t, svd).EqualsApprox(m, 1e-9))
+
+	vvt, _ := vt.Mul(vt.Transpose())
+	assert.True(t, vvt.EqualsApprox(NewIdentity(3), 1e-9))
+}
+
+func TestSVD_FullCompletesRankDeficientFactors(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{1, 1},
+		{1, 1},
+		{0, 0},
+	})
+
+	svd := m.SVDFull()
+	u := svd.U()
+	utu, _ := u.Transpose().Mul(u)
+
+	assert.InDeltaSlice(t, []float64{2, 0}, svd.Values(), 1e-9)
+	assert.True(t, utu.EqualsApprox(NewIdentity(3), 1e-9))
+	assert.True(t, reconstructSVD(t, svd).EqualsApprox(m, 1e-9))
+}
+
+func TestPseudoInverse_InvertibleMatrix(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{4, 7},
+		{2, 6},
+	})
+
+	inverse, err := m.Invert()
+	require.NoError(t, err)
+
+	assert.True(t, m.PseudoInverse().EqualsApprox(inverse, 1e-9))
+}
+
+func TestPseudoInverse_RankDeficientMatrix(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{1, 2},
+		{2, 4},
+		{3, 6},
+	})
+
+	pinv := m.PseudoInverse()
+
+	assert.Equal(t, 2, pinv.nbRows)
+	assert.Equal(t, 3, pinv.nbCols)
+	// Moore-Penrose conditions: A * A⁺ * A = A and A⁺ * A * A⁺ = A⁺
+	apa, _ := m.Mul(pinv)
+	apa, _ = apa.Mul(m)
+	pap, _ := pinv.Mul(m)
+	pap, _ = pap.Mul(pinv)
+	assert.True(t, apa.EqualsApprox(m, 1e-9))
+	assert.True(t, pap.EqualsApprox(pinv, 1e-9))
+}
+
+func TestNorm2(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{3, 0},
+		{4, 5},
+	})
+
+	assert.InDelta(t, math.Sqrt(45), m.Norm2(), 1e-9)
+	assert.Equal(t, 0.0, New(0, 0).Norm2())
+}
+
+func TestCond(t *testing.T) {
+	m := NewDiagonal([]float64{10, 2, 0.5})
+	singular, _ := NewFromData([][]float64{
+		{1, 2},
+		{2, 4},
+	})
+
+	cond, err := m.Cond(SpectralNorm)
+	require.NoError(t, err)
+	singularCond, err := singular.Cond(SpectralNorm)
+	require.NoError(t, err)
+
+	assert.InDelta(t, 20.0, cond, 1e-9)
+	assert.True(t, math.IsInf(singularCond, 1))
+}
+
+func TestNumericalRank(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{1, 0, 0},
+		{0, 1e-3, 0},
+		{0, 0, 1e-14},
+	})
+
+	assert.Equal(t, 3, m.NumericalRank(0))
+	assert.Equal(t, 2, m.NumericalRank(1e-10))
+	assert.Equal(t, 1, m.NumericalRank(1e-2))
+}
@@ -0,0 +1,199 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/JoLandry/linalgo/vector"
+)
+
+// SymDense is a square symmetric matrix.
+//
+// Only the upper triangle is stored, packed row by row, which takes n(n+1)/2
+// elements. Since the element (i, j) and the element (j, i) are the same stored
+// value, the matrix stays exactly symmetric after any update.
+type SymDense struct {
+	n    int
+	data []float64
+}
+
+// Creates and returns a zero n x n symmetric matrix.
+//
+// It panics if n is negative.
+func NewSymDense(n int) *SymDense {
+	if n < 0 {
+		panic("size of a symmetric matrix must be non-negative")
+	}
+
+	return &SymDense{n: n, data: make([]float64, n*(n+1)/2)}
+}
+
+// Creates and returns the symmetric matrix holding the elements of a dense matrix.
+//
+// The two halves are averaged, to remove rounding differences.
+// Returns an error if the matrix is not square or not symmetric, with a tolerance
+// relative to its largest element.
+func NewSymDenseFromDense(m *Matrix) (*SymDense, error) {
+	if !m.IsSquare() {
+		return nil, fmt.Errorf("cannot build a symmetric matrix from a non-square matrix")
+	}
+	if !m.IsSymmetric(symmetryTolerance * math.Max(1.0, m.maxAbs())) {
+		return nil, fmt.Errorf("matrix is not symmetric, cannot build a symmetric matrix")
+	}
+
+	result := NewSymDense(m.nbRows)
+	for i := 0; i < m.nbRows; i++ {
+		for j := i; j < m.nbCols; j++ {
+			result.data[result.index(i, j)] = (m.row(i)[j] + m.row(j)[i]) / 2.0
+		}
+	}
+
+	return result, nil
+}
+
+// Returns the index of the element (i, j) in data, where the element
+// is stored once for both (i, j) and (j, i).
+//
+// It panics if the indices are out of range, which the packed storage
+// would otherwise map to another element.
+func (s *SymDense) index(i, j int) int {
+	if i < 0 || i >= s.n || j < 0 || j >= s.n {
+		panic(fmt.Sprintf("index (%d, %d) out of range for a %dx%d symmetric matrix", i, j, s.n, s.n))
+	}
+	if i > j {
+		i, j = j, i
+	}
+	// Rows 0 to i-1 hold n, n-1, ..., n-i+1 elements
+	return i*s.n - i*(i-1)/2 + j - i
+}
+
+// Get the size (number of rows and columns) of the matrix
+func (s *SymDense) GetSize() int {
+	return s.n
+}
+
+// Returns the number of rows and the number of columns of the matrix.
+func (s *SymDense) Dims() (int, int) {
+	return s.n, s.n
+}
+
+// Get the element at row row and column col
+func (s *SymDense) GetElementAt(row int, col int) float64 {
+	return s.data[s.index(row, col)]
+}
+
+// Set the element called elt at row row and column col, and therefore
+// at row col and column row as well
+func (s *SymDense) SetElementAt(row int, col int, elt float64) {
+	s.data[s.index(row, col)] = elt
+}
+
+// Returns the matrix as a dense matrix.
+func (s *SymDense) ToDense() *Matrix {
+	result := New(s.n, s.n)
+	for i := 0; i < s.n; i++ {
+		for j := i; j < s.n; j++ {
+			val := s.data[s.index(i, j)]
+			result.row(i)[j] = val
+			result.row(j)[i] = val
+		}
+	}
+
+	return result
+}
+
+// Performs the product between the matrix and a vector.
+//
+// Returns an error if the size of the vector does not match the size of the matrix.
+func (s *SymDense) MulVec(v *vector.Vector) (*vector.Vector, error) {
+	result := vector.New(s.n)
+	if err := s.Apply(v, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Stores the product between the matrix and x into y, without allocating.
+//
+// Returns an error if the dimensions do not agree or if y is x.
+func (s *SymDense) Apply(x, y *vector.Vector) error {
+	if err := checkApply(x, y, s.n, s.n); err != nil {
+		return err
+	}
+
+	// Each stored element contributes to two elements of the result
+	values, result := x.GetData(), y.GetData()
+	clear(result)
+	for i := 0; i < s.n; i++ {
+		start := s.index(i, i)
+		result[i] += s.data[start] * values[i]
+		for j := i + 1; j < s.n; j++ {
+			val := s.data[start+j-i]
+			result[i] += val * values[j]
+			result[j] += val * values[i]
+		}
+	}
+
+	return nil
+}
+
+// Performs the symmetric rank-1 update A = A + alpha * x * x^T, in place.
+//
+// Returns an error if the size of x does not match the size of the matrix.
+func (s *SymDense) RankOneUpdate(alpha float64, x *vector.Vector) error {
+	if x.GetSize() != s.n {
+		return fmt.Errorf("mismatch between size of matrix (%d) and size of vector (%d), cannot perform rank-1 update", s.n, x.GetSize())
+	}
+
+	values := x.GetData()
+	for i := 0; i < s.n; i++ {
+		start := s.index(i, i)
+		factor := alpha * values[i]
+		for j := i; j < s.n; j++ {
+			s.data[start+j-i] += factor * values[j]
+		}
+	}
+
+	return nil
+}
+
+// Performs the symmetric rank-k update A = A + alpha * B * B^T, in place,
+// where B has as many rows as A and k columns.
+//
+// Returns an error if the number of rows of b does not match the size of the matrix.
+func (s *SymDense) RankKUpdate(alpha float64, b *Matrix) error {
+	if b.nbRows != s.n {
+		return fmt.Errorf("mismatch between size of matrix (%d) and number of rows of update (%d), cannot perform rank-k update", s.n, b.nbRows)
+	}
+
+	for i := 0; i < s.n; i++ {
+		start := s.index(i, i)
+		rowI := b.row(i)
+		for j := i; j < s.n; j++ {
+			sum := 0.0
+			for k, val := range b.row(j) {
+				sum += rowI[k] * val
+			}
+			s.data[start+j-i] += alpha * sum
+		}
+	}
+
+	return nil
+}
+
+// Computes and returns the eigen-decomposition of the matrix.
+//
+// It uses the cyclic Jacobi eigenvalue algorithm, like Matrix.EigenSym,
+// without any symmetry check. The original matrix is not modified.
+func (s *SymDense) EigenSym() *EigenSym {
+	return jacobiEigen(s.ToDense())
+}
+
+// Computes and returns the Cholesky factorization of the matrix.
+//
+// Returns an error wrapping ErrNotPositiveDefinite if the matrix is not positive definite.
+// The original matrix is not modified.
+func (s *SymDense) Cholesky() (*Cholesky, error) {
+	return choleskyLower(s.ToDense())
+}
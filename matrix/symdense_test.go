@@ -0,0 +1,186 @@
+package matrix
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/JoLandry/linalgo/vector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSymDense_ShouldPanic_NegativeSize(t *testing.T) {
+	assert.Panics(t, func() { NewSymDense(-1) })
+}
+
+func TestSymDense_StoresOneTriangle(t *testing.T) {
+	s := NewSymDense(4)
+
+	assert.Len(t, s.data, 10)
+	assert.Equal(t, 4, s.GetSize())
+	rows, cols := s.Dims()
+	assert.Equal(t, 4, rows)
+	assert.Equal(t, 4, cols)
+}
+
+func TestSymDense_SetElementAt_SetsBothHalves(t *testing.T) {
+	s := NewSymDense(3)
+
+	s.SetElementAt(2, 0, 7)
+
+	assert.Equal(t, 7.0, s.GetElementAt(0, 2))
+	assert.Equal(t, 7.0, s.GetElementAt(2, 0))
+	assert.Equal(t, 0.0, s.GetElementAt(1, 0))
+}
+
+func TestSymDense_ElementAt_ShouldPanic_OutOfRange(t *testing.T) {
+	s := NewSymDense(3)
+
+	assert.Panics(t, func() { s.SetElementAt(0, 3, 7) })
+	assert.Panics(t, func() { s.GetElementAt(-1, 0) })
+	assert.Equal(t, 0.0, s.GetElementAt(1, 1))
+}
+
+func TestNewSymDenseFromDense_RoundTrip(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{4, 1, -2},
+		{1, 5, 3},
+		{-2, 3, 6},
+	})
+
+	s, err := NewSymDenseFromDense(m)
+	require.NoError(t, err)
+
+	assert.True(t, s.ToDense().EqualsApprox(m, 0))
+}
+
+func TestNewSymDenseFromDense_ShouldFail(t *testing.T) {
+	nonSymmetric, _ := NewFromData([][]float64{
+		{1, 2},
+		{3, 4},
+	})
+
+	_, err := NewSymDenseFromDense(New(2, 3))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "non-square")
+
+	_, err = NewSymDenseFromDense(nonSymmetric)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not symmetric")
+}
+
+func TestSymDense_MulVec_MatchesDense(t *testing.T) {
+	s, _ := NewSymDenseFromDense(NewFromFlat(3, 3, []float64{
+		4, 1, -2,
+		1, 5, 3,
+		-2, 3, 6,
+	}))
+	v := vector.NewFromData([]float64{1, -2, 3})
+
+	result, err := s.MulVec(v)
+	require.NoError(t, err)
+	expected, _ := s.ToDense().MulVec(v)
+
+	assert.Equal(t, expected.GetData(), result.GetData())
+}
+
+func TestSymDense_Apply_ShouldFail(t *testing.T) {
+	s := NewSymDense(3)
+	v := vector.New(3)
+
+	assert.Error(t, s.Apply(vector.New(2), v))
+	assert.Error(t, s.Apply(v, v))
+	_, err := s.MulVec(vector.New(4))
+	assert.Error(t, err)
+}
+
+func TestSymDense_IsLinearOperator(t *testing.T) {
+	var op LinearOperator = NewSymDense(3)
+
+	rows, cols := op.Dims()
+
+	assert.Equal(t, 3, rows)
+	assert.Equal(t, 3, cols)
+}
+
+func TestSymDense_RankOneUpdate(t *testing.T) {
+	s, _ := NewSymDenseFromDense(NewFromFlat(3, 3, []float64{
+		4, 1, -2,
+		1, 5, 3,
+		-2, 3, 6,
+	}))
+	x := vector.NewFromData([]float64{1, 2, -1})
+	expected := s.ToDense()
+	outer, _ := NewFromData([][]float64{
+		{1, 2, -1},
+		{2, 4, -2},
+		{-1, -2, 1},
+	})
+	expected, _ = expected.Add(outer.MulScalar(0.5))
+
+	err := s.RankOneUpdate(0.5, x)
+	require.NoError(t, err)
+
+	assert.True(t, s.ToDense().EqualsApprox(expected, 1e-12))
+	assert.Error(t, s.RankOneUpdate(1, vector.New(2)))
+}
+
+func TestSymDense_RankKUpdate(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	s, _ := NewSymDenseFromDense(NewFromFlat(3, 3, []float64{
+		4, 1, -2,
+		1, 5, 3,
+		-2, 3, 6,
+	}))
+	b := newRandomMatrix(rng, 3, 5)
+	bbt, _ := b.Mul(b.Transpose())
+	expected, _ := s.ToDense().Add(bbt.MulScalar(-2))
+
+	err := s.RankKUpdate(-2, b)
+	require.NoError(t, err)
+
+	assert.True(t, s.ToDense().EqualsApprox(expected, 1e-12))
+	assert.True(t, s.ToDense().IsSymmetric(0))
+	assert.Error(t, s.RankKUpdate(1, New(2, 3)))
+}
+
+func TestSymDense_EigenSym_MatchesDense(t *testing.T) {
+	s, _ := NewSymDenseFromDense(NewFromFlat(3, 3, []float64{
+		4, 1, -2,
+		1, 5, 3,
+		-2, 3, 6,
+	}))
+
+	eigen := s.EigenSym()
+	expected, err := s.ToDense().EigenSym()
+	require.NoError(t, err)
+
+	assert.InDeltaSlice(t, expected.Values(), eigen.Values(), 1e-12)
+}
+
+func TestSymDense_Cholesky(t *testing.T) {
+	s := NewSymDense(3)
+	// Covariance built from rank-1 updates
+	for _, sample := range [][]float64{{1, 0, 1}, {0, 2, 1}, {1, 1, 0}, {2, -1, 1}} {
+		require.NoError(t, s.RankOneUpdate(1, vector.NewFromData(sample)))
+	}
+
+	chol, err := s.Cholesky()
+	require.NoError(t, err)
+
+	l := chol.L()
+	product, _ := l.Mul(l.Transpose())
+	assert.True(t, product.EqualsApprox(s.ToDense(), 1e-12))
+}
+
+func TestSymDense_Cholesky_ShouldFail_NotPositiveDefinite(t *testing.T) {
+	s := NewSymDense(2)
+	s.SetElementAt(0, 0, 1)
+	s.SetElementAt(0, 1, 2)
+	s.SetElementAt(1, 1, 1)
+
+	_, err := s.Cholesky()
+
+	assert.True(t, errors.Is(err, ErrNotPositiveDefinite))
+}
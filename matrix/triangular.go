@@ -0,0 +1,308 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/JoLandry/linalgo/vector"
+)
+
+// TriangleKind tells which triangle of a triangular matrix holds its elements.
+type TriangleKind int
+
+const (
+	// Elements on and above the diagonal
+	Upper TriangleKind = iota
+	// Elements on and below the diagonal
+	Lower
+)
+
+// Triangular is a square upper or lower triangular matrix.
+//
+// Only the triangle is stored, packed row by row, which takes n(n+1)/2 elements.
+// A unit triangular matrix has an implicit diagonal of ones: its stored diagonal
+// is ignored, as factors such as L of the LU factorization usually are.
+type Triangular struct {
+	n    int
+	kind TriangleKind
+	unit bool
+	data []float64
+}
+
+// Creates and returns a zero n x n triangular matrix of the given kind.
+//
+// If unit is true, the diagonal is made of implicit ones.
+// It panics if n is negative.
+func NewTriangular(n int, kind TriangleKind, unit bool) *Triangular {
+	if n < 0 {
+		panic("size of a triangular matrix must be non-negative")
+	}
+
+	return &Triangular{n: n, kind: kind, unit: unit, data: make([]float64, n*(n+1)/2)}
+}
+
+// Creates and returns the triangular matrix of the given kind holding the
+// elements of a dense matrix.
+//
+// If unit is true, the diagonal of m is ignored and replaced by ones.
+// Returns an error if the matrix is not square, or if it has a non-zero element
+// outside of the triangle.
+func NewTriangularFromDense(m *Matrix, kind TriangleKind, unit bool) (*Triangular, error) {
+	if !m.IsSquare() {
+		return nil, fmt.Errorf("cannot build a triangular matrix from a non-square matrix")
+	}
+
+	result := NewTriangular(m.nbRows, kind, unit)
+	for i := 0; i < m.nbRows; i++ {
+		for j, val := range m.row(i) {
+			if result.inTriangle(i, j) {
+				result.data[result.index(i, j)] = val
+			} else if val != 0.0 {
+				return nil, fmt.Errorf("non-zero element at (%d, %d) outside of the triangle", i, j)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// Tells whether the element (i, j) is within the stored triangle.
+func (t *Triangular) inTriangle(i, j int) bool {
+	if t.kind == Upper {
+		return j >= i
+	}
+	return j <= i
+}
+
+// Panics if (i, j) is not a position of the matrix, which the packed
+// storage would otherwise map to another element.
+func (t *Triangular) checkIndices(i, j int) {
+	if i < 0 || i >= t.n || j < 0 || j >= t.n {
+		panic(fmt.Sprintf("index (%d, %d) out of range for a %dx%d triangular matrix", i, j, t.n, t.n))
+	}
+}
+
+// Returns the index of the element (i, j) of the triangle in data.
+func (t *Triangular) index(i, j int) int {
+	if t.kind == Upper {
+		// Rows 0 to i-1 hold n, n-1, ..., n-i+1 elements
+		return i*t.n - i*(i-1)/2 + j - i
+	}
+	// Rows 0 to i-1 hold 1, 2, ..., i elements
+	return i*(i+1)/2 + j
+}
+
+// Returns the range of columns [start, end) of the triangle on row i.
+func (t *Triangular) colRange(i int) (int, int) {
+	if t.kind == Upper {
+		return i, t.n
+	}
+	return 0, i + 1
+}
+
+// Returns the diagonal element of row i, one for unit triangular matrices.
+func (t *Triangular) diagonal(i int) float64 {
+	if t.unit {
+		return 1.0
+	}
+	return t.data[t.index(i, i)]
+}
+
+// Get the size (number of rows and columns) of the matrix
+func (t *Triangular) GetSize() int {
+	return t.n
+}
+
+// Returns the number of rows and the number of columns of the matrix.
+func (t *Triangular) Dims() (int, int) {
+	return t.n, t.n
+}
+
+// Returns whether the matrix is upper or lower triangular.
+func (t *Triangular) Kind() TriangleKind {
+	return t.kind
+}
+
+// Tells whether the matrix has an implicit unit diagonal.
+func (t *Triangular) IsUnit() bool {
+	return t.unit
+}
+
+// Get the element at row row and column col, zero outside of the triangle
+//
+// It panics if the indices are out of range.
+func (t *Triangular) GetElementAt(row int, col int) float64 {
+	t.checkIndices(row, col)
+	if row == col {
+		return t.diagonal(row)
+	}
+	if !t.inTriangle(row, col) {
+		return 0.0
+	}
+	return t.data[t.index(row, col)]
+}
+
+// Set the element called elt at row row and column col
+//
+// It panics if the indices are out of range, if the position is outside of
+// the triangle, or on the diagonal of a unit triangular matrix.
+func (t *Triangular) SetElementAt(row int, col int, elt float64) {
+	t.checkIndices(row, col)
+	if !t.inTriangle(row, col) {
+		panic(fmt.Sprintf("position (%d, %d) is outside of the triangle", row, col))
+	}
+	if t.unit && row == col {
+		panic(fmt.Sprintf("cannot set the diagonal element (%d, %d) of a unit triangular matrix", row, col))
+	}
+	t.data[t.index(row, col)] = elt
+}
+
+// Returns the matrix as a dense matrix.
+func (t *Triangular) ToDense() *Matrix {
+	result := New(t.n, t.n)
+	for i := 0; i < t.n; i++ {
+		start, end := t.colRange(i)
+		row := result.row(i)
+		for j := start; j < end; j++ {
+			row[j] = t.data[t.index(i, j)]
+		}
+		row[i] = t.diagonal(i)
+	}
+
+	return result
+}
+
+// Performs the product between the matrix and a vector, in O(n²) operations.
+//
+// Returns an error if the size of the vector does not match the size of the matrix.
+func (t *Triangular) MulVec(v *vector.Vector) (*vector.Vector, error) {
+	result := vector.New(t.n)
+	if err := t.Apply(v, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Stores the product between the matrix and x into y, without allocating.
+//
+// Returns an error if the dimensions do not agree or if y is x.
+func (t *Triangular) Apply(x, y *vector.Vector) error {
+	if err := checkApply(x, y, t.n, t.n); err != nil {
+		return err
+	}
+
+	values, result := x.GetData(), y.GetData()
+	for i := 0; i < t.n; i++ {
+		start, end := t.colRange(i)
+		sum := 0.0
+		for j := start; j < end; j++ {
+			if j != i {
+				sum += t.data[t.index(i, j)] * values[j]
+			}
+		}
+		result[i] = sum + t.diagonal(i)*values[i]
+	}
+
+	return nil
+}
+
+// Returns the determinant of the matrix, namely the product of its diagonal.
+//
+// By convention, the determinant of an empty (0x0) matrix is zero.
+func (t *Triangular) Determinant() float64 {
+	if t.n == 0 {
+		return 0.0
+	}
+	determinant := 1.0
+	for i := 0; i < t.n; i++ {
+		determinant *= t.diagonal(i)
+	}
+
+	return determinant
+}
+
+// Tells whether the matrix is singular, namely whether one of its
+// diagonal elements is (numerically) zero.
+//
+// By convention, an empty (0x0) matrix is considered singular.
+func (t *Triangular) IsSingular() bool {
+	if t.n == 0 {
+		return true
+	}
+	for i := 0; i < t.n; i++ {
+		if math.Abs(t.diagonal(i)) < pivotTolerance {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Solves T * x = b by forward (lower) or back (upper) substitution, in O(n²) operations.
+//
+// Returns an error if the size of b does not match the size of the matrix,
+// or an error wrapping ErrSingular if the matrix is singular.
+func (t *Triangular) SolveVec(b *vector.Vector) (*vector.Vector, error) {
+	x, err := t.SolveMat(NewFromFlat(b.GetSize(), 1, b.GetData()))
+	if err != nil {
+		return nil, err
+	}
+
+	return x.colToVector(0), nil
+}
+
+// Solves T * X = B, where each column of B is a right-hand side, in O(n² * k)
+// operations for k right-hand sides.
+//
+// Returns an error if B does not have as many rows as the matrix,
+// or an error wrapping ErrSingular if the matrix is singular.
+func (t *Triangular) SolveMat(b *Matrix) (*Matrix, error) {
+	if b.nbRows != t.n {
+		return nil, fmt.Errorf("mismatch between size of triangular matrix (%d) and number of rows of right-hand side (%d)", t.n, b.nbRows)
+	}
+	if t.IsSingular() {
+		return nil, fmt.Errorf("%w, cannot solve", ErrSingular)
+	}
+
+	x := b.Copy()
+	// Rows are solved from the top for lower matrices, from the bottom for upper ones
+	for step := 0; step < t.n; step++ {
+		i := step
+		if t.kind == Upper {
+			i = t.n - 1 - step
+		}
+		xRow := x.row(i)
+		start, end := t.colRange(i)
+		for j := start; j < end; j++ {
+			if j == i {
+				continue
+			}
+			factor := t.data[t.index(i, j)]
+			for c, val := range x.row(j) {
+				xRow[c] -= factor * val
+			}
+		}
+		if !t.unit {
+			diagonal := t.data[t.index(i, i)]
+			for c := range xRow {
+				xRow[c] /= diagonal
+			}
+		}
+	}
+
+	return x, nil
+}
+
+// Returns the inverse of the matrix, which is triangular of the same kind.
+//
+// Returns an error wrapping ErrSingular if the matrix is singular.
+func (t *Triangular) Inverse() (*Triangular, error) {
+	inv, err := t.SolveMat(NewIdentity(t.n))
+	if err != nil {
+		return nil, err
+	}
+
+	// The inverse of a unit triangular matrix has a unit diagonal as well
+	return NewTriangularFromDense(inv, t.kind, t.unit)
+}
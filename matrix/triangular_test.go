@@ -0,0 +1,174 @@
+package matrix
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/JoLandry/linalgo/vector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTriangular_PackedStorage(t *testing.T) {
+	upper := NewTriangular(3, Upper, false)
+	lower := NewTriangular(3, Lower, false)
+
+	upper.SetElementAt(1, 2, 7)
+	lower.SetElementAt(2, 0, 8)
+
+	assert.Len(t, upper.data, 6)
+	assert.Equal(t, Upper, upper.Kind())
+	assert.Equal(t, 7.0, upper.GetElementAt(1, 2))
+	assert.Equal(t, 0.0, upper.GetElementAt(2, 1))
+	assert.Equal(t, 8.0, lower.GetElementAt(2, 0))
+	assert.Panics(t, func() { upper.SetElementAt(2, 1, 1) })
+	assert.Panics(t, func() { NewTriangular(2, Lower, true).SetElementAt(1, 1, 1) })
+}
+
+func TestTriangular_ElementAt_ShouldPanic_OutOfRange(t *testing.T) {
+	upper := NewTriangular(3, Upper, false)
+	lower := NewTriangular(3, Lower, false)
+
+	assert.Panics(t, func() { upper.SetElementAt(0, 3, 7) })
+	assert.Panics(t, func() { upper.GetElementAt(0, 3) })
+	assert.Panics(t, func() { lower.SetElementAt(2, -1, 7) })
+	assert.Panics(t, func() { lower.GetElementAt(3, 3) })
+	assert.Equal(t, 0.0, upper.GetElementAt(1, 1))
+	assert.Equal(t, 0.0, lower.GetElementAt(1, 1))
+}
+
+func TestNewTriangularFromDense(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{2, 0, 0},
+		{1, 3, 0},
+		{-1, 4, 5},
+	})
+
+	lower, err := NewTriangularFromDense(m, Lower, false)
+	_, errUpper := NewTriangularFromDense(m, Upper, false)
+	_, errNonSquare := NewTriangularFromDense(New(2, 3), Lower, false)
+
+	require.NoError(t, err)
+	assert.Equal(t, m.GetData(), lower.ToDense().GetData())
+	assert.Equal(t, 3, lower.GetSize())
+	assert.ErrorContains(t, errUpper, "outside of the triangle")
+	assert.Error(t, errNonSquare)
+}
+
+func TestTriangular_UnitDiagonal(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{2, 0, 0},
+		{1, 3, 0},
+		{-1, 4, 5},
+	})
+	unit, err := NewTriangularFromDense(m, Lower, true)
+	require.NoError(t, err)
+
+	assert.True(t, unit.IsUnit())
+	assert.Equal(t, 1.0, unit.GetElementAt(2, 2))
+	assert.Equal(t, 1.0, unit.Determinant())
+	assert.Equal(t, [][]float64{{1, 0, 0}, {1, 1, 0}, {-1, 4, 1}}, unit.ToDense().GetData())
+}
+
+func TestTriangular_MulVec(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{2, 0, 0},
+		{1, 3, 0},
+		{-1, 4, 5},
+	})
+	v := vector.NewFromData([]float64{1, -1, 2})
+	for _, unit := range []bool{false, true} {
+		tri, _ := NewTriangularFromDense(m, Lower, unit)
+		expected, _ := tri.ToDense().MulVec(v)
+
+		product, err := tri.MulVec(v)
+
+		require.NoError(t, err)
+		assert.Equal(t, expected.GetData(), product.GetData())
+	}
+	_, err := NewTriangular(3, Upper, false).MulVec(vector.New(2))
+	assert.Error(t, err)
+}
+
+func TestTriangular_SolveVec(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{2, 0, 0},
+		{1, 3, 0},
+		{-1, 4, 5},
+	})
+	b := vector.NewFromData([]float64{2, 5, 9})
+	lower, _ := NewTriangularFromDense(m, Lower, false)
+	upper, _ := NewTriangularFromDense(m.Transpose(), Upper, false)
+	unit, _ := NewTriangularFromDense(m, Lower, true)
+	for _, tri := range []*Triangular{lower, upper, unit} {
+		x, err := tri.SolveVec(b)
+		require.NoError(t, err)
+
+		product, _ := tri.MulVec(x)
+		assert.InDeltaSlice(t, b.GetData(), product.GetData(), 1e-12)
+	}
+}
+
+func TestTriangular_SolveMat(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{2, 1, -1},
+		{0, 3, 4},
+		{0, 0, 5},
+	})
+	upper, err := NewTriangularFromDense(m, Upper, false)
+	require.NoError(t, err)
+	b, _ := NewFromData([][]float64{{1, 0}, {0, 1}, {2, 3}})
+
+	x, err := upper.SolveMat(b)
+	require.NoError(t, err)
+
+	product, _ := upper.ToDense().Mul(x)
+	assert.True(t, product.EqualsApprox(b, 1e-12))
+	_, err = upper.SolveMat(New(2, 2))
+	assert.Error(t, err)
+}
+
+func TestTriangular_Singular(t *testing.T) {
+	tri := NewTriangular(2, Upper, false)
+	tri.SetElementAt(0, 0, 1)
+
+	_, errSolve := tri.SolveVec(vector.New(2))
+	_, errInverse := tri.Inverse()
+
+	assert.True(t, tri.IsSingular())
+	assert.Equal(t, 0.0, tri.Determinant())
+	assert.True(t, errors.Is(errSolve, ErrSingular))
+	assert.True(t, errors.Is(errInverse, ErrSingular))
+	assert.True(t, NewTriangular(0, Lower, false).IsSingular())
+}
+
+func TestTriangular_DeterminantAndInverse(t *testing.T) {
+	m, _ := NewFromData([][]float64{
+		{2, 0, 0},
+		{1, 3, 0},
+		{-1, 4, 5},
+	})
+	lower, err := NewTriangularFromDense(m, Lower, false)
+	require.NoError(t, err)
+
+	inv, err := lower.Inverse()
+	require.NoError(t, err)
+	product, _ := lower.ToDense().Mul(inv.ToDense())
+
+	assert.Equal(t, 30.0, lower.Determinant())
+	assert.Equal(t, Lower, inv.Kind())
+	assert.True(t, product.EqualsApprox(NewIdentity(3), 1e-12))
+}
+
+func TestTriangular_FromFactorization(t *testing.T) {
+	m, _ := NewFromData([][]float64{{4, 3}, {6, 3}})
+	lu, err := m.LU()
+	require.NoError(t, err)
+
+	l, err := NewTriangularFromDense(lu.L(), Lower, true)
+	require.NoError(t, err)
+	u, err := NewTriangularFromDense(lu.U(), Upper, false)
+	require.NoError(t, err)
+
+	assert.InDelta(t, lu.Determinant(), -l.Determinant()*u.Determinant(), 1e-12)
+}
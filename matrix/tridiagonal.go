@@ -0,0 +1,195 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/JoLandry/linalgo/vector"
+)
+
+// Tridiagonal is a square matrix whose only non-zero elements are on the
+// diagonal, the first subdiagonal and the first superdiagonal.
+//
+// Only these three diagonals are stored, which takes O(n) memory, and systems
+// are solved in O(n) operations with the Thomas algorithm.
+type Tridiagonal struct {
+	// lower[i] is the element (i+1, i)
+	lower []float64
+	diag  []float64
+	// upper[i] is the element (i, i+1)
+	upper []float64
+}
+
+// Creates and returns a tridiagonal matrix from its three diagonals (deep copy).
+//
+// The subdiagonal lower and the superdiagonal upper must have one element less than diag,
+// lower[i] being the element (i+1, i) and upper[i] the element (i, i+1).
+// Returns an error if the lengths of the diagonals are inconsistent.
+func NewTridiagonal(lower, diag, upper []float64) (*Tridiagonal, error) {
+	n := len(diag)
+	if n == 0 && len(lower) == 0 && len(upper) == 0 {
+		return &Tridiagonal{lower: []float64{}, diag: []float64{}, upper: []float64{}}, nil
+	}
+	if len(lower) != n-1 || len(upper) != n-1 {
+		return nil, fmt.Errorf("inconsistent lengths of diagonals: expected %d, %d and %d, got %d, %d and %d", n-1, n, n-1, len(lower), n, len(upper))
+	}
+
+	return &Tridiagonal{
+		lower: append([]float64{}, lower...),
+		diag:  append([]float64{}, diag...),
+		upper: append([]float64{}, upper...),
+	}, nil
+}
+
+// Get the size (number of rows and columns) of the matrix
+func (t *Tridiagonal) GetSize() int {
+	return len(t.diag)
+}
+
+// Returns the number of rows and the number of columns of the matrix.
+func (t *Tridiagonal) Dims() (int, int) {
+	return len(t.diag), len(t.diag)
+}
+
+// Get the element at row row and column col, zero outside of the three diagonals
+func (t *Tridiagonal) GetElementAt(row int, col int) float64 {
+	switch col - row {
+	case -1:
+		return t.lower[col]
+	case 0:
+		return t.diag[row]
+	case 1:
+		return t.upper[row]
+	default:
+		return 0.0
+	}
+}
+
+// Returns a copy of the subdiagonal, the element i being (i+1, i).
+func (t *Tridiagonal) Lower() []float64 {
+	return append([]float64{}, t.lower...)
+}
+
+// Returns a copy of the diagonal.
+func (t *Tridiagonal) Diagonal() []float64 {
+	return append([]float64{}, t.diag...)
+}
+
+// Returns a copy of the superdiagonal, the element i being (i, i+1).
+func (t *Tridiagonal) Upper() []float64 {
+	return append([]float64{}, t.upper...)
+}
+
+// Returns the matrix as a dense matrix.
+func (t *Tridiagonal) ToDense() *Matrix {
+	n := len(t.diag)
+	result := New(n, n)
+	for i := 0; i < n; i++ {
+		row := result.row(i)
+		row[i] = t.diag[i]
+		if i > 0 {
+			row[i-1] = t.lower[i-1]
+		}
+		if i < n-1 {
+			row[i+1] = t.upper[i]
+		}
+	}
+
+	return result
+}
+
+// Returns the matrix as a banded matrix with one subdiagonal and one superdiagonal.
+func (t *Tridiagonal) ToBanded() *Banded {
+	n := len(t.diag)
+	result := NewBanded(n, 1, 1)
+	for i := 0; i < n; i++ {
+		result.SetElementAt(i, i, t.diag[i])
+		if i > 0 {
+			result.SetElementAt(i, i-1, t.lower[i-1])
+		}
+		if i < n-1 {
+			result.SetElementAt(i, i+1, t.upper[i])
+		}
+	}
+
+	return result
+}
+
+// Performs the product between the matrix and a vector, in O(n) operations.
+//
+// Returns an error if the size of the vector does not match the size of the matrix.
+func (t *Tridiagonal) MulVec(v *vector.Vector) (*vector.Vector, error) {
+	result := vector.New(len(t.diag))
+	if err := t.Apply(v, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Stores the product between the matrix and x into y, without allocating.
+//
+// Returns an error if the dimensions do not agree or if y is x.
+func (t *Tridiagonal) Apply(x, y *vector.Vector) error {
+	n := len(t.diag)
+	if err := checkApply(x, y, n, n); err != nil {
+		return err
+	}
+
+	values, result := x.GetData(), y.GetData()
+	for i := 0; i < n; i++ {
+		sum := t.diag[i] * values[i]
+		if i > 0 {
+			sum += t.lower[i-1] * values[i-1]
+		}
+		if i < n-1 {
+			sum += t.upper[i] * values[i+1]
+		}
+		result[i] = sum
+	}
+
+	return nil
+}
+
+// Solves the system T * x = b with the Thomas algorithm, in O(n) operations.
+//
+// The Thomas algorithm is Gaussian elimination without pivoting: it is stable
+// for diagonally dominant or symmetric positive definite matrices. For other
+// matrices, use ToBanded and solve with the pivoted banded LU factorization.
+//
+// Returns an error if the size of b does not match the size of the matrix,
+// or an error wrapping ErrSingular if a zero pivot is met.
+func (t *Tridiagonal) Solve(b *vector.Vector) (*vector.Vector, error) {
+	n := len(t.diag)
+	if b.GetSize() != n {
+		return nil, fmt.Errorf("mismatch between size of matrix (%d) and size of right-hand side (%d)", n, b.GetSize())
+	}
+	if n == 0 {
+		return nil, fmt.Errorf("%w, cannot solve", ErrSingular)
+	}
+
+	// Forward sweep: modified superdiagonal and right-hand side
+	upper := make([]float64, n)
+	x := vector.NewFromData(b.GetData())
+	values := x.GetData()
+	for i := 0; i < n; i++ {
+		pivot := t.diag[i]
+		if i > 0 {
+			pivot -= t.lower[i-1] * upper[i-1]
+			values[i] -= t.lower[i-1] * values[i-1]
+		}
+		if math.Abs(pivot) < pivotTolerance {
+			return nil, fmt.Errorf("%w: zero pivot at row %d, cannot solve", ErrSingular, i)
+		}
+		if i < n-1 {
+			upper[i] = t.upper[i] / pivot
+		}
+		values[i] /= pivot
+	}
+	// Back substitution
+	for i := n - 2; i >= 0; i-- {
+		values[i] -= upper[i] * values[i+1]
+	}
+
+	return x, nil
+}
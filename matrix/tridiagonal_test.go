@@ -0,0 +1,105 @@
+package matrix
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/JoLandry/linalgo/vector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTridiagonal(t *testing.T) {
+	lower := []float64{1, 2}
+	tri, err := NewTridiagonal(lower, []float64{4, 5, 6}, []float64{-1, -2})
+	require.NoError(t, err)
+
+	lower[0] = 100
+
+	assert.Equal(t, 3, tri.GetSize())
+	assert.Equal(t, [][]float64{{4, -1, 0}, {1, 5, -2}, {0, 2, 6}}, tri.ToDense().GetData())
+	assert.Equal(t, []float64{1, 2}, tri.Lower())
+	assert.Equal(t, []float64{4, 5, 6}, tri.Diagonal())
+	assert.Equal(t, []float64{-1, -2}, tri.Upper())
+	assert.Equal(t, 0.0, tri.GetElementAt(0, 2))
+}
+
+func TestNewTridiagonal_ShouldFail_InconsistentLengths(t *testing.T) {
+	_, err := NewTridiagonal([]float64{1}, []float64{1, 2, 3}, []float64{1, 2})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "inconsistent lengths")
+}
+
+func TestTridiagonal_Empty(t *testing.T) {
+	tri, err := NewTridiagonal(nil, nil, nil)
+	require.NoError(t, err)
+
+	_, err = tri.Solve(vector.New(0))
+
+	assert.Equal(t, 0, tri.GetSize())
+	assert.True(t, errors.Is(err, ErrSingular))
+}
+
+func TestTridiagonal_MulVec(t *testing.T) {
+	tri, _ := NewTridiagonal([]float64{1, 2}, []float64{4, 5, 6}, []float64{-1, -2})
+	v := vector.NewFromData([]float64{1, 2, 3})
+	expected, _ := tri.ToDense().MulVec(v)
+
+	product, err := tri.MulVec(v)
+
+	require.NoError(t, err)
+	assert.Equal(t, expected.GetData(), product.GetData())
+	_, err = tri.MulVec(vector.New(2))
+	assert.Error(t, err)
+}
+
+func TestTridiagonal_Solve(t *testing.T) {
+	n := 1000
+	lower, diag, upper := make([]float64, n-1), make([]float64, n), make([]float64, n-1)
+	for i := 0; i < n; i++ {
+		diag[i] = 4
+		if i < n-1 {
+			lower[i] = -1
+			upper[i] = -2
+		}
+	}
+	tri, _ := NewTridiagonal(lower, diag, upper)
+	expected := vector.New(n)
+	for i := 0; i < n; i++ {
+		expected.SetElementAt(i, float64(i%5))
+	}
+	b, _ := tri.MulVec(expected)
+
+	x, err := tri.Solve(b)
+
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, expected.GetData(), x.GetData(), 1e-10)
+}
+
+func TestTridiagonal_Solve_ShouldFail(t *testing.T) {
+	// Not singular, but the Thomas algorithm needs pivoting on it
+	tri, _ := NewTridiagonal([]float64{1}, []float64{0, 1}, []float64{1})
+
+	_, errPivot := tri.Solve(vector.NewFromData([]float64{1, 2}))
+	_, errSize := tri.Solve(vector.New(3))
+	x, errBanded := tri.ToBanded().Solve(vector.NewFromData([]float64{1, 2}))
+
+	assert.True(t, errors.Is(errPivot, ErrSingular))
+	assert.Error(t, errSize)
+	require.NoError(t, errBanded)
+	assert.InDeltaSlice(t, []float64{1, 1}, x.GetData(), 1e-12)
+}
+
+func TestTridiagonal_IsLinearOperator(t *testing.T) {
+	var op LinearOperator
+	op, _ = NewTridiagonal([]float64{1}, []float64{2, 3}, []float64{4})
+	y := vector.New(2)
+
+	nbRows, nbCols := op.Dims()
+	require.NoError(t, op.Apply(vector.NewFromData([]float64{1, 1}), y))
+
+	assert.Equal(t, 2, nbRows)
+	assert.Equal(t, 2, nbCols)
+	assert.Equal(t, []float64{6, 4}, y.GetData())
+}
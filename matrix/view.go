@@ -0,0 +1,125 @@
+package matrix
+
+import (
+	"fmt"
+
+	"github.com/JoLandry/linalgo/vector"
+)
+
+// Returns a view of the submatrix made of rows r0 (included) to r1 (excluded)
+// and columns c0 (included) to c1 (excluded).
+//
+// The view shares the storage of the calling matrix: no element is copied,
+// and modifications through the view are visible in the parent (and conversely).
+// Use Copy on the view to get an independent matrix.
+//
+// Returns an error if the bounds are out of range or not ordered.
+func (m *MatrixOf[T]) Slice(r0, r1, c0, c1 int) (*MatrixOf[T], error) {
+	if r0 < 0 || r1 > m.nbRows || r0 > r1 {
+		return nil, fmt.Errorf("invalid row range [%d, %d) for a matrix with %d rows", r0, r1, m.nbRows)
+	}
+	if c0 < 0 || c1 > m.nbCols || c0 > c1 {
+		return nil, fmt.Errorf("invalid column range [%d, %d) for a matrix with %d columns", c0, c1, m.nbCols)
+	}
+
+	view := &MatrixOf[T]{
+		data:   []T{},
+		stride: m.stride,
+		nbRows: r1 - r0,
+		nbCols: c1 - c0,
+	}
+	if view.nbRows > 0 && view.nbCols > 0 {
+		view.data = m.data[r0*m.stride+c0 : (r1-1)*m.stride+c1]
+	} else {
+		// No storage to step through, so that every row is the empty slice
+		view.stride = 0
+	}
+
+	return view, nil
+}
+
+// Returns a copy of the row i of the matrix as a vector.
+//
+// Returns an error if the index is out of range.
+func (m *MatrixOf[T]) Row(i int) (*vector.VectorOf[T], error) {
+	if i < 0 || i >= m.nbRows {
+		return nil, fmt.Errorf("row index %d out of range for a matrix with %d rows", i, m.nbRows)
+	}
+
+	return vector.NewFromDataOf(m.row(i)), nil
+}
+
+// Returns a copy of the column j of the matrix as a vector.
+//
+// Returns an error if the index is out of range.
+func (m *MatrixOf[T]) Col(j int) (*vector.VectorOf[T], error) {
+	if j < 0 || j >= m.nbCols {
+		return nil, fmt.Errorf("column index %d out of range for a matrix with %d columns", j, m.nbCols)
+	}
+
+	return m.colToVector(j), nil
+}
+
+// Returns copies of the columns of the matrix as vectors, for instance
+// to get a basis returned by NullSpace or ColumnSpace as a slice of vectors.
+func (m *MatrixOf[T]) Columns() []*vector.VectorOf[T] {
+	columns := make([]*vector.VectorOf[T], m.nbCols)
+	for j := range columns {
+		columns[j] = m.colToVector(j)
+	}
+
+	return columns
+}
+
+// Overwrites the row i of the matrix with the elements of v.
+//
+// Returns an error if the index is out of range or if the size of v
+// does not match the number of columns.
+func (m *MatrixOf[T]) SetRow(i int, v *vector.VectorOf[T]) error {
+	if i < 0 || i >= m.nbRows {
+		return fmt.Errorf("row index %d out of range for a matrix with %d rows", i, m.nbRows)
+	}
+	if v.GetSize() != m.nbCols {
+		return fmt.Errorf("mismatch between number of columns of matrix (%d) and size of vector (%d), cannot set row", m.nbCols, v.GetSize())
+	}
+
+	copy(m.row(i), v.GetData())
+
+	return nil
+}
+
+// Overwrites the column j of the matrix with the elements of v.
+//
+// Returns an error if the index is out of range or if the size of v
+// does not match the number of rows.
+func (m *MatrixOf[T]) SetCol(j int, v *vector.VectorOf[T]) error {
+	if j < 0 || j >= m.nbCols {
+		return fmt.Errorf("column index %d out of range for a matrix with %d columns", j, m.nbCols)
+	}
+	if v.GetSize() != m.nbRows {
+		return fmt.Errorf("mismatch between number of rows of matrix (%d) and size of vector (%d), cannot set column", m.nbRows, v.GetSize())
+	}
+
+	for i, val := range v.GetData() {
+		m.row(i)[j] = val
+	}
+
+	return nil
+}
+
+// Overwrites the block of the matrix starting at (row, col) with the elements of block.
+//
+// Returns an error if the block does not fit in the matrix at the given position.
+func (m *MatrixOf[T]) SetBlock(row, col int, block *MatrixOf[T]) error {
+	if row < 0 || col < 0 || row+block.nbRows > m.nbRows || col+block.nbCols > m.nbCols {
+		return fmt.Errorf("block of size %dx%d at (%d, %d) does not fit in a %dx%d matrix", block.nbRows, block.nbCols, row, col, m.nbRows, m.nbCols)
+	}
+
+	// Copy first, in case block is a view overlapping the matrix
+	source := block.Copy()
+	for i := 0; i < source.nbRows; i++ {
+		copy(m.row(row + i)[col:col+source.nbCols], source.row(i))
+	}
+
+	return nil
+}
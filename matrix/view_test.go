@@ -0,0 +1,203 @@
+package matrix
+
+import (
+	"testing"
+
+	"github.com/JoLandry/linalgo/vector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSequenceMatrix(nbRows, nbCols int) *Matrix {
+	values := make([]float64, nbRows*nbCols)
+	for i := range values {
+		values[i] = float64(i + 1)
+	}
+	return NewFromFlat(nbRows, nbCols, values)
+}
+
+func TestSlice_ReturnsSubmatrix(t *testing.T) {
+	m := newSequenceMatrix(3, 4)
+
+	view, err := m.Slice(1, 3, 1, 3)
+
+	require.NoError(t, err)
+	assert.Equal(t, [][]float64{{6, 7}, {10, 11}}, view.GetData())
+	assert.Equal(t, 2, view.GetNbRows())
+	assert.Equal(t, 2, view.GetNbCols())
+}
+
+func TestSlice_SharesStorageWithParent(t *testing.T) {
+	m := newSequenceMatrix(3, 3)
+
+	view, err := m.Slice(0, 2, 1, 3)
+	require.NoError(t, err)
+
+	view.SetElementAt(1, 0, 50)
+	assert.Equal(t, 50.0, m.GetElementAt(1, 1))
+
+	m.SetElementAt(0, 2, 30)
+	assert.Equal(t, 30.0, view.GetElementAt(0, 1))
+}
+
+func TestSlice_OfSlice(t *testing.T) {
+	m := newSequenceMatrix(4, 4)
+
+	outer, err := m.Slice(1, 4, 1, 4)
+	require.NoError(t, err)
+	inner, err := outer.Slice(1, 3, 0, 2)
+	require.NoError(t, err)
+
+	assert.Equal(t, [][]float64{{10, 11}, {14, 15}}, inner.GetData())
+}
+
+func TestSlice_OperationsOnView(t *testing.T) {
+	m := newSequenceMatrix(3, 3)
+	view, err := m.Slice(1, 3, 1, 3)
+	require.NoError(t, err)
+
+	sum, err := view.Add(NewIdentity(2))
+	require.NoError(t, err)
+	det, err := view.Determinant()
+	require.NoError(t, err)
+
+	assert.Equal(t, [][]float64{{6, 6}, {8, 10}}, sum.GetData())
+	assert.InDelta(t, 5*9-6*8, det, 1e-9)
+	assert.Equal(t, [][]float64{{5, 8}, {6, 9}}, view.Transpose().GetData())
+}
+
+func TestSlice_CopyIsIndependent(t *testing.T) {
+	m := newSequenceMatrix(2, 2)
+	view, _ := m.Slice(0, 1, 0, 2)
+
+	copied := view.Copy()
+	copied.SetElementAt(0, 0, 100)
+
+	assert.Equal(t, 1.0, m.GetElementAt(0, 0))
+	assert.Equal(t, copied.nbCols, copied.stride)
+}
+
+func TestSlice_ShouldFail_OutOfRange(t *testing.T) {
+	m := New(2, 2)
+
+	_, errRows := m.Slice(0, 3, 0, 1)
+	_, errCols := m.Slice(0, 1, 1, 0)
+
+	assert.Error(t, errRows)
+	assert.Contains(t, errRows.Error(), "invalid row range")
+	assert.Error(t, errCols)
+	assert.Contains(t, errCols.Error(), "invalid column range")
+}
+
+func TestSlice_Empty(t *testing.T) {
+	m := New(2, 2)
+
+	view, err := m.Slice(1, 1, 0, 2)
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, view.GetNbRows())
+	assert.Equal(t, "[]", view.String())
+
+	// Rows but no columns
+	noCols, err := newSequenceMatrix(3, 3).Slice(0, 2, 1, 1)
+	require.NoError(t, err)
+	copied := noCols.Copy()
+	sum, err := noCols.Add(copied)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, copied.GetNbRows())
+	assert.Equal(t, 0, copied.GetNbCols())
+	assert.Equal(t, 2, sum.GetNbRows())
+	assert.True(t, noCols.EqualsApprox(sum, 0))
+}
+
+func TestElementAt_ShouldPanic_OutOfRange(t *testing.T) {
+	m := newSequenceMatrix(3, 3)
+	view, _ := m.Slice(0, 2, 0, 2)
+
+	assert.Panics(t, func() { m.GetElementAt(0, 3) })
+	assert.Panics(t, func() { m.GetElementAt(-1, 0) })
+	assert.Panics(t, func() { view.SetElementAt(0, 2, 99) })
+	assert.Panics(t, func() { view.GetElementAt(2, 0) })
+	// The parent is left untouched
+	assert.Equal(t, 3.0, m.GetElementAt(0, 2))
+}
+
+func TestRowAndCol(t *testing.T) {
+	m := newSequenceMatrix(2, 3)
+
+	row, err := m.Row(1)
+	require.NoError(t, err)
+	col, err := m.Col(2)
+	require.NoError(t, err)
+
+	assert.Equal(t, []float64{4, 5, 6}, row.GetData())
+	assert.Equal(t, []float64{3, 6}, col.GetData())
+
+	// Returned vectors are copies
+	row.SetElementAt(0, 40)
+	assert.Equal(t, 4.0, m.GetElementAt(1, 0))
+
+	_, err = m.Row(2)
+	assert.Error(t, err)
+	_, err = m.Col(-1)
+	assert.Error(t, err)
+}
+
+func TestColumns(t *testing.T) {
+	m := newSequenceMatrix(2, 3)
+
+	columns := m.Columns()
+
+	require.Len(t, columns, 3)
+	assert.Equal(t, []float64{1, 4}, columns[0].GetData())
+	assert.Equal(t, []float64{3, 6}, columns[2].GetData())
+	columns[0].SetElementAt(0, 10)
+	assert.Equal(t, 1.0, m.GetElementAt(0, 0))
+	assert.Empty(t, New(2, 0).Columns())
+}
+
+func TestSetRowAndSetCol(t *testing.T) {
+	m := New(2, 3)
+
+	err := m.SetRow(0, vector.NewFromData([]float64{1, 2, 3}))
+	require.NoError(t, err)
+	err = m.SetCol(2, vector.NewFromData([]float64{7, 8}))
+	require.NoError(t, err)
+
+	assert.Equal(t, [][]float64{{1, 2, 7}, {0, 0, 8}}, m.GetData())
+	assert.Error(t, m.SetRow(0, vector.New(2)))
+	assert.Error(t, m.SetCol(0, vector.New(3)))
+	assert.Error(t, m.SetRow(5, vector.New(3)))
+}
+
+func TestSetRow_ThroughView(t *testing.T) {
+	m := New(3, 3)
+	view, _ := m.Slice(1, 3, 1, 3)
+
+	err := view.SetRow(1, vector.NewFromData([]float64{4, 5}))
+
+	require.NoError(t, err)
+	assert.Equal(t, [][]float64{{0, 0, 0}, {0, 0, 0}, {0, 4, 5}}, m.GetData())
+}
+
+func TestSetBlock(t *testing.T) {
+	m := New(3, 3)
+	block, _ := NewFromData([][]float64{{1, 2}, {3, 4}})
+
+	err := m.SetBlock(1, 0, block)
+
+	require.NoError(t, err)
+	assert.Equal(t, [][]float64{{0, 0, 0}, {1, 2, 0}, {3, 4, 0}}, m.GetData())
+	assert.Error(t, m.SetBlock(2, 2, block))
+}
+
+func TestSetBlock_OverlappingView(t *testing.T) {
+	m := newSequenceMatrix(3, 3)
+	view, _ := m.Slice(0, 2, 0, 2)
+
+	err := m.SetBlock(1, 1, view)
+
+	require.NoError(t, err)
+	assert.Equal(t, [][]float64{{1, 2, 3}, {4, 1, 2}, {7, 4, 5}}, m.GetData())
+}
@@ -0,0 +1,101 @@
+package solver
+
+import (
+	"github.com/JoLandry/linalgo/matrix"
+	"github.com/JoLandry/linalgo/vector"
+)
+
+// Solves A * x = b with the BiCGSTAB (stabilized bi-conjugate gradient) method.
+//
+// Unlike GMRES, the memory does not grow with the number of iterations, but the
+// convergence can be irregular. Each iteration costs two products with the operator.
+// The preconditioner, if any, is applied on the right, so the residual history
+// measures the residual of the original system.
+// The solver stops with the Breakdown reason if one of the scalars the method
+// divides by vanishes.
+//
+// Returns an error if the operator is not square, or if the dimensions of b or
+// of the initial guess do not match. Not converging is not an error: check the
+// Reason of the returned result.
+func BiCGSTAB(a matrix.LinearOperator, b *vector.Vector, settings *Settings) (*Result, error) {
+	s, x, err := prepare(a, b, settings)
+	if err != nil {
+		return nil, err
+	}
+	result, r, bNorm, err := initialize(a, b, x, s)
+	if err != nil || result.Converged() {
+		return result, err
+	}
+
+	n := b.GetSize()
+	shadow := vector.NewFromData(r.GetData())
+	p, v, sVec, t := vector.New(n), vector.New(n), vector.New(n), vector.New(n)
+	// Preconditioned directions M^-1 * p and M^-1 * s
+	pHat, sHat := vector.New(n), vector.New(n)
+	rho, alpha, omega := 1.0, 1.0, 1.0
+	for result.Iterations < s.maxIterations {
+		rhoNext := dot(shadow, r)
+		if rhoNext == 0.0 {
+			result.Reason = Breakdown
+			break
+		}
+
+		// p = r + beta * (p - omega * v)
+		beta := (rhoNext / rho) * (alpha / omega)
+		_ = vector.AddScaledTo(p, p, -omega, v)
+		_ = vector.AddScaledTo(p, r, beta, p)
+
+		if err := s.preconditioner.Precondition(p, pHat); err != nil {
+			return nil, err
+		}
+		if err := a.Apply(pHat, v); err != nil {
+			return nil, err
+		}
+		denominator := dot(shadow, v)
+		if denominator == 0.0 {
+			result.Reason = Breakdown
+			break
+		}
+		alpha = rhoNext / denominator
+		_ = vector.AddScaledTo(sVec, r, -alpha, v)
+
+		// Half step: the residual of x + alpha * M^-1 * p is s
+		_ = vector.AddScaledTo(x, x, alpha, pHat)
+		result.Iterations++
+		sNorm := sVec.Norm()
+		if sNorm/bNorm <= s.tolerance {
+			result.ResidualHistory = append(result.ResidualHistory, sNorm/bNorm)
+			result.Reason = Converged
+			break
+		}
+
+		if err := s.preconditioner.Precondition(sVec, sHat); err != nil {
+			return nil, err
+		}
+		if err := a.Apply(sHat, t); err != nil {
+			return nil, err
+		}
+		tt := dot(t, t)
+		if tt == 0.0 {
+			result.ResidualHistory = append(result.ResidualHistory, sNorm/bNorm)
+			result.Reason = Breakdown
+			break
+		}
+		omega = dot(t, sVec) / tt
+		_ = vector.AddScaledTo(x, x, omega, sHat)
+		_ = vector.AddScaledTo(r, sVec, -omega, t)
+
+		result.ResidualHistory = append(result.ResidualHistory, r.Norm()/bNorm)
+		if result.Residual() <= s.tolerance {
+			result.Reason = Converged
+			break
+		}
+		if omega == 0.0 {
+			result.Reason = Breakdown
+			break
+		}
+		rho = rhoNext
+	}
+
+	return result, nil
+}
@@ -0,0 +1,49 @@
+package solver
+
+import (
+	"testing"
+
+	"github.com/JoLandry/linalgo/sparse"
+	"github.com/JoLandry/linalgo/vector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBiCGSTAB_NonSymmetric(t *testing.T) {
+	a := newNonSymmetric(40)
+	b := vector.New(40)
+	b.AddScalarInPlace(1)
+	expected, _ := a.Solve(b)
+
+	result, err := BiCGSTAB(a, b, &Settings{Tolerance: 1e-12})
+
+	require.NoError(t, err)
+	assert.True(t, result.Converged())
+	assert.InDeltaSlice(t, expected.GetData(), result.X.GetData(), 1e-8)
+}
+
+func TestBiCGSTAB_SparseCSC(t *testing.T) {
+	dense := newNonSymmetric(200)
+	a := sparse.NewCSCFromDense(dense)
+	b := vector.New(200)
+	b.SetElementAt(0, 1)
+	b.SetElementAt(199, -1)
+
+	result, err := BiCGSTAB(a, b, nil)
+
+	require.NoError(t, err)
+	assert.True(t, result.Converged())
+	assert.Less(t, relativeResidual(t, a, result.X, b), 1e-7)
+}
+
+func TestBiCGSTAB_Breakdown(t *testing.T) {
+	// The residual is orthogonal to its image: rho vanishes after one step
+	a := sparse.NewCSRFromDense(newRotation())
+	b := vector.NewFromData([]float64{1, 0})
+
+	result, err := BiCGSTAB(a, b, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, Breakdown, result.Reason)
+	assert.Len(t, result.ResidualHistory, result.Iterations+1)
+}
@@ -0,0 +1,70 @@
+package solver
+
+import (
+	"math"
+
+	"github.com/JoLandry/linalgo/matrix"
+	"github.com/JoLandry/linalgo/vector"
+)
+
+// Solves A * x = b with the Conjugate Gradient method.
+//
+// The operator must be symmetric positive definite: the method then converges
+// in at most n iterations in exact arithmetic. If a direction of non-positive
+// curvature is met, which proves A is not positive definite, the solver stops
+// with the Breakdown reason.
+// The preconditioner, if any, must be symmetric positive definite as well,
+// such as Jacobi, SSOR or IC0 on a symmetric positive definite operator.
+//
+// Returns an error if the operator is not square, or if the dimensions of b or
+// of the initial guess do not match. Not converging is not an error: check the
+// Reason of the returned result.
+func CG(a matrix.LinearOperator, b *vector.Vector, settings *Settings) (*Result, error) {
+	s, x, err := prepare(a, b, settings)
+	if err != nil {
+		return nil, err
+	}
+	result, r, bNorm, err := initialize(a, b, x, s)
+	if err != nil || result.Converged() {
+		return result, err
+	}
+
+	// Preconditioned residual z = M^-1 * r
+	z := vector.New(b.GetSize())
+	if err := s.preconditioner.Precondition(r, z); err != nil {
+		return nil, err
+	}
+	p := vector.NewFromData(z.GetData())
+	ap := vector.New(b.GetSize())
+	rz := dot(r, z)
+	for result.Iterations < s.maxIterations {
+		if err := a.Apply(p, ap); err != nil {
+			return nil, err
+		}
+		curvature := dot(p, ap)
+		if curvature <= 0.0 || math.IsNaN(curvature) {
+			result.Reason = Breakdown
+			break
+		}
+
+		alpha := rz / curvature
+		_ = vector.AddScaledTo(x, x, alpha, p)
+		_ = vector.AddScaledTo(r, r, -alpha, ap)
+		result.Iterations++
+
+		result.ResidualHistory = append(result.ResidualHistory, r.Norm()/bNorm)
+		if result.Residual() <= s.tolerance {
+			result.Reason = Converged
+			break
+		}
+
+		if err := s.preconditioner.Precondition(r, z); err != nil {
+			return nil, err
+		}
+		rzNext := dot(r, z)
+		_ = vector.AddScaledTo(p, z, rzNext/rz, p)
+		rz = rzNext
+	}
+
+	return result, nil
+}
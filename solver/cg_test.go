@@ -0,0 +1,56 @@
+package solver
+
+import (
+	"testing"
+
+	"github.com/JoLandry/linalgo/matrix"
+	"github.com/JoLandry/linalgo/vector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCG_DenseSPD(t *testing.T) {
+	a, _ := matrix.NewFromData([][]float64{
+		{4, 1, 0},
+		{1, 3, 1},
+		{0, 1, 2},
+	})
+	b := vector.NewFromData([]float64{1, 2, 3})
+	expected, _ := a.Solve(b)
+
+	result, err := CG(a, b, nil)
+
+	require.NoError(t, err)
+	assert.True(t, result.Converged())
+	// At most n iterations in exact arithmetic
+	assert.LessOrEqual(t, result.Iterations, 4)
+	assert.InDeltaSlice(t, expected.GetData(), result.X.GetData(), 1e-7)
+}
+
+func TestCG_LargeSparse(t *testing.T) {
+	n := 2000
+	a := newLaplacian(n)
+	expected := vector.New(n)
+	for i := 0; i < n; i++ {
+		expected.SetElementAt(i, float64(i%7)-3)
+	}
+	b, _ := a.MulVec(expected)
+
+	result, err := CG(a, b, &Settings{Tolerance: 1e-12})
+
+	require.NoError(t, err)
+	assert.True(t, result.Converged())
+	assert.LessOrEqual(t, result.Iterations, n)
+	assert.InDeltaSlice(t, expected.GetData(), result.X.GetData(), 1e-5)
+}
+
+func TestCG_Breakdown_IndefiniteMatrix(t *testing.T) {
+	a := matrix.NewDiagonal([]float64{1, -1})
+	b := vector.NewFromData([]float64{1, 1})
+
+	result, err := CG(a, b, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, Breakdown, result.Reason)
+	assert.Len(t, result.ResidualHistory, result.Iterations+1)
+}
@@ -0,0 +1,127 @@
+package solver
+
+import (
+	"math"
+
+	"github.com/JoLandry/linalgo/matrix"
+	"github.com/JoLandry/linalgo/vector"
+)
+
+// Solves A * x = b with the restarted GMRES method.
+//
+// Each cycle builds an orthonormal basis of the Krylov subspace with the Arnoldi
+// process (modified Gram-Schmidt), and picks the element of the subspace that
+// minimizes the residual, using Givens rotations to update the least-squares
+// problem at each iteration. The method restarts every Settings.Restart iterations
+// to bound the memory, which holds Restart+1 vectors of size n.
+// The preconditioner, if any, is applied on the right, solving A * M^-1 * u = b
+// with x = M^-1 * u, so the minimized residual is the one of the original system.
+//
+// The residual history holds the residual norm estimated by the Givens rotations.
+// The solver stops with the Breakdown reason if the operator is singular
+// on the Krylov subspace.
+//
+// Returns an error if the operator is not square, or if the dimensions of b or
+// of the initial guess do not match. Not converging is not an error: check the
+// Reason of the returned result.
+func GMRES(a matrix.LinearOperator, b *vector.Vector, settings *Settings) (*Result, error) {
+	s, x, err := prepare(a, b, settings)
+	if err != nil {
+		return nil, err
+	}
+	result, r, bNorm, err := initialize(a, b, x, s)
+	if err != nil || result.Converged() {
+		return result, err
+	}
+
+	n, m := b.GetSize(), s.restart
+	basis := make([]*vector.Vector, m+1)
+	for i := range basis {
+		basis[i] = vector.New(n)
+	}
+	// Hessenberg matrix, column by column: h[j] holds the j+2 first elements of column j
+	h := make([][]float64, m)
+	for j := range h {
+		h[j] = make([]float64, j+2)
+	}
+	cs, sn := make([]float64, m), make([]float64, m)
+	// Preconditioned vectors M^-1 * v
+	z := vector.New(n)
+	g := make([]float64, m+1)
+
+	for result.Iterations < s.maxIterations {
+		beta := r.Norm()
+		_ = vector.MulScalarTo(basis[0], r, 1.0/beta)
+		clear(g)
+		g[0] = beta
+
+		k := 0
+		for k < m && result.Iterations < s.maxIterations {
+			j := k
+			if err := s.preconditioner.Precondition(basis[j], z); err != nil {
+				return nil, err
+			}
+			w := basis[j+1]
+			if err := a.Apply(z, w); err != nil {
+				return nil, err
+			}
+			// Arnoldi process with modified Gram-Schmidt
+			for i := 0; i <= j; i++ {
+				h[j][i] = dot(w, basis[i])
+				_ = vector.AddScaledTo(w, w, -h[j][i], basis[i])
+			}
+			h[j][j+1] = w.Norm()
+			if h[j][j+1] != 0.0 {
+				w.MulScalarInPlace(1.0 / h[j][j+1])
+			}
+
+			// Apply the previous rotations to the new column, then eliminate its last element
+			for i := 0; i < j; i++ {
+				h[j][i], h[j][i+1] = cs[i]*h[j][i]+sn[i]*h[j][i+1], -sn[i]*h[j][i]+cs[i]*h[j][i+1]
+			}
+			norm := math.Hypot(h[j][j], h[j][j+1])
+			if norm == 0.0 {
+				result.Reason = Breakdown
+				break
+			}
+			cs[j], sn[j] = h[j][j]/norm, h[j][j+1]/norm
+			h[j][j], h[j][j+1] = norm, 0.0
+			g[j], g[j+1] = cs[j]*g[j], -sn[j]*g[j]
+
+			k++
+			result.Iterations++
+			result.ResidualHistory = append(result.ResidualHistory, math.Abs(g[j+1])/bNorm)
+			if result.Residual() <= s.tolerance {
+				result.Reason = Converged
+				break
+			}
+		}
+
+		// Solve the triangular least-squares system and update the solution
+		y := make([]float64, k)
+		for i := k - 1; i >= 0; i-- {
+			sum := g[i]
+			for l := i + 1; l < k; l++ {
+				sum -= h[l][i] * y[l]
+			}
+			y[i] = sum / h[i][i]
+		}
+		clear(z.GetData())
+		for i := 0; i < k; i++ {
+			_ = vector.AddScaledTo(z, z, y[i], basis[i])
+		}
+		if err := s.preconditioner.Precondition(z, z); err != nil {
+			return nil, err
+		}
+		_ = vector.AddScaledTo(x, x, 1.0, z)
+
+		if result.Reason != MaxIterationsReached {
+			break
+		}
+		if err := residual(r, a, x, b); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
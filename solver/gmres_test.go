@@ -0,0 +1,85 @@
+package solver
+
+import (
+	"testing"
+
+	"github.com/JoLandry/linalgo/matrix"
+	"github.com/JoLandry/linalgo/vector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Non-symmetric convection-diffusion like matrix
+func newNonSymmetric(n int) *matrix.Matrix {
+	m := matrix.New(n, n)
+	for i := 0; i < n; i++ {
+		m.SetElementAt(i, i, 3)
+		if i > 0 {
+			m.SetElementAt(i, i-1, -1.5)
+		}
+		if i < n-1 {
+			m.SetElementAt(i, i+1, -0.5)
+		}
+	}
+	return m
+}
+
+func TestGMRES_NonSymmetric(t *testing.T) {
+	a := newNonSymmetric(40)
+	b := vector.New(40)
+	b.AddScalarInPlace(1)
+	expected, _ := a.Solve(b)
+
+	result, err := GMRES(a, b, nil)
+
+	require.NoError(t, err)
+	assert.True(t, result.Converged())
+	assert.InDeltaSlice(t, expected.GetData(), result.X.GetData(), 1e-6)
+}
+
+func TestGMRES_NoRestartConvergesInNIterations(t *testing.T) {
+	a, _ := matrix.NewFromData([][]float64{
+		{1, 2, 0},
+		{0, 1, 3},
+		{4, 0, 1},
+	})
+	b := vector.NewFromData([]float64{1, 0, -1})
+
+	result, err := GMRES(a, b, &Settings{Tolerance: 1e-12})
+
+	require.NoError(t, err)
+	assert.True(t, result.Converged())
+	assert.LessOrEqual(t, result.Iterations, 3)
+	assert.Less(t, relativeResidual(t, a, result.X, b), 1e-10)
+}
+
+func TestGMRES_Restarted(t *testing.T) {
+	a := newNonSymmetric(60)
+	b := vector.New(60)
+	for i := 0; i < 60; i++ {
+		b.SetElementAt(i, float64(i))
+	}
+
+	result, err := GMRES(a, b, &Settings{Restart: 5, Tolerance: 1e-10})
+
+	require.NoError(t, err)
+	assert.True(t, result.Converged())
+	assert.Greater(t, result.Iterations, 5)
+	assert.Less(t, relativeResidual(t, a, result.X, b), 1e-8)
+}
+
+func TestGMRES_Breakdown_SingularMatrix(t *testing.T) {
+	a := matrix.NewDiagonal([]float64{1, 0})
+	b := vector.NewFromData([]float64{0, 1})
+
+	result, err := GMRES(a, b, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, Breakdown, result.Reason)
+}
+
+// Rotation by 90 degrees: A * v is always orthogonal to v
+func newRotation() *matrix.Matrix {
+	m, _ := matrix.NewFromData([][]float64{{0, -1}, {1, 0}})
+	return m
+}
@@ -0,0 +1,177 @@
+package solver
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/JoLandry/linalgo/matrix"
+	"github.com/JoLandry/linalgo/vector"
+)
+
+// ILU0 is the incomplete LU factorization preconditioner with zero fill-in, M = L * U.
+//
+// L is unit lower triangular and U upper triangular, and both keep the sparsity
+// pattern of A: the elimination only updates elements that are already stored.
+type ILU0 struct {
+	// Strictly lower part holds L (without its unit diagonal), the rest holds U
+	lu *rowStorage
+}
+
+// IC0 is the incomplete Cholesky factorization preconditioner with zero fill-in, M = L * L^T.
+//
+// L is lower triangular and keeps the sparsity pattern of the lower part of A.
+// It requires a symmetric operator, and is meant to be used with CG.
+type IC0 struct {
+	// Rows of L, diagonal included
+	l *rowStorage
+}
+
+// Computes and returns the ILU(0) preconditioner of the operator.
+//
+// The operator must be a *matrix.Matrix, a *sparse.CSR or a *sparse.CSC.
+// Returns an error if the operator is not square, if its diagonal has a zero,
+// or if a zero pivot appears during the incomplete factorization.
+func NewILU0(a matrix.LinearOperator) (*ILU0, error) {
+	lu, err := newRowStorage(a)
+	if err != nil {
+		return nil, err
+	}
+
+	// Position of each element of the current row, -1 if not stored
+	position := make([]int, lu.n)
+	for j := range position {
+		position[j] = -1
+	}
+	for i := 0; i < lu.n; i++ {
+		for e := lu.ptr[i]; e < lu.ptr[i+1]; e++ {
+			position[lu.idx[e]] = e
+		}
+		// Eliminate with each previous row k, in increasing order
+		for e := lu.ptr[i]; e < lu.diag[i]; e++ {
+			k := lu.idx[e]
+			lu.values[e] /= lu.values[lu.diag[k]]
+			for f := lu.diag[k] + 1; f < lu.ptr[k+1]; f++ {
+				if pos := position[lu.idx[f]]; pos >= 0 {
+					lu.values[pos] -= lu.values[e] * lu.values[f]
+				}
+			}
+		}
+		if lu.values[lu.diag[i]] == 0.0 {
+			return nil, fmt.Errorf("%w: zero pivot at row %d of the incomplete LU factorization", matrix.ErrSingular, i)
+		}
+		for e := lu.ptr[i]; e < lu.ptr[i+1]; e++ {
+			position[lu.idx[e]] = -1
+		}
+	}
+
+	return &ILU0{lu: lu}, nil
+}
+
+// Stores M^-1 * r into z. Returns an error if the dimensions do not match.
+func (p *ILU0) Precondition(r, z *vector.Vector) error {
+	lu := p.lu
+	if err := checkPreconditionSizes(r, z, lu.n); err != nil {
+		return err
+	}
+	_ = vector.CopyTo(z, r)
+	values := z.GetData()
+
+	// Forward substitution with L, then backward substitution with U
+	for i := 0; i < lu.n; i++ {
+		for e := lu.ptr[i]; e < lu.diag[i]; e++ {
+			values[i] -= lu.values[e] * values[lu.idx[e]]
+		}
+	}
+	for i := lu.n - 1; i >= 0; i-- {
+		sum := values[i]
+		for e := lu.diag[i] + 1; e < lu.ptr[i+1]; e++ {
+			sum -= lu.values[e] * values[lu.idx[e]]
+		}
+		values[i] = sum / lu.values[lu.diag[i]]
+	}
+
+	return nil
+}
+
+// Computes and returns the IC(0) preconditioner of the operator.
+//
+// Only the lower part of the operator is read, so it is assumed symmetric.
+// The operator must be a *matrix.Matrix, a *sparse.CSR or a *sparse.CSC.
+// Returns an error if the operator is not square, if its diagonal has a zero,
+// or an error wrapping matrix.ErrNotPositiveDefinite if a non-positive pivot
+// appears during the incomplete factorization.
+func NewIC0(a matrix.LinearOperator) (*IC0, error) {
+	storage, err := newRowStorage(a)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the lower part of each row, diagonal included
+	l := &rowStorage{n: storage.n, ptr: make([]int, storage.n+1), diag: make([]int, storage.n)}
+	for i := 0; i < storage.n; i++ {
+		l.idx = append(l.idx, storage.idx[storage.ptr[i]:storage.diag[i]+1]...)
+		l.values = append(l.values, storage.values[storage.ptr[i]:storage.diag[i]+1]...)
+		l.ptr[i+1] = len(l.idx)
+		l.diag[i] = len(l.idx) - 1
+	}
+
+	for i := 0; i < l.n; i++ {
+		for e := l.ptr[i]; e <= l.diag[i]; e++ {
+			k := l.idx[e]
+			// Subtract the product of rows i and k of L, over the columns before k
+			sum := l.values[e]
+			f, g := l.ptr[i], l.ptr[k]
+			for f < e && g < l.diag[k] {
+				switch {
+				case l.idx[f] < l.idx[g]:
+					f++
+				case l.idx[f] > l.idx[g]:
+					g++
+				default:
+					sum -= l.values[f] * l.values[g]
+					f++
+					g++
+				}
+			}
+
+			if k < i {
+				l.values[e] = sum / l.values[l.diag[k]]
+				continue
+			}
+			if sum <= 0.0 {
+				return nil, fmt.Errorf("%w: non-positive pivot at row %d of the incomplete Cholesky factorization", matrix.ErrNotPositiveDefinite, i)
+			}
+			l.values[e] = math.Sqrt(sum)
+		}
+	}
+
+	return &IC0{l: l}, nil
+}
+
+// Stores M^-1 * r into z. Returns an error if the dimensions do not match.
+func (p *IC0) Precondition(r, z *vector.Vector) error {
+	l := p.l
+	if err := checkPreconditionSizes(r, z, l.n); err != nil {
+		return err
+	}
+	_ = vector.CopyTo(z, r)
+	values := z.GetData()
+
+	// Forward substitution with L
+	for i := 0; i < l.n; i++ {
+		sum := values[i]
+		for e := l.ptr[i]; e < l.diag[i]; e++ {
+			sum -= l.values[e] * values[l.idx[e]]
+		}
+		values[i] = sum / l.values[l.diag[i]]
+	}
+	// Backward substitution with L^T, going through the rows of L as columns of L^T
+	for i := l.n - 1; i >= 0; i-- {
+		values[i] /= l.values[l.diag[i]]
+		for e := l.ptr[i]; e < l.diag[i]; e++ {
+			values[l.idx[e]] -= l.values[e] * values[i]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package solver
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/JoLandry/linalgo/matrix"
+	"github.com/JoLandry/linalgo/vector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestILU0_ExactOnTridiagonal(t *testing.T) {
+	// No fill-in: the incomplete factorization is the exact LU factorization
+	a := newNonSymmetric(6)
+	p, err := NewILU0(a)
+	require.NoError(t, err)
+	r := vector.NewFromData([]float64{1, 2, 3, 4, 5, 6})
+	expected, _ := a.Solve(r)
+	z := vector.New(6)
+
+	require.NoError(t, p.Precondition(r, z))
+
+	assert.InDeltaSlice(t, expected.GetData(), z.GetData(), 1e-12)
+}
+
+func TestILU0_ShouldFail_ZeroPivot(t *testing.T) {
+	a, _ := matrix.NewFromData([][]float64{{1, 1}, {1, 1}})
+
+	_, err := NewILU0(a)
+
+	assert.True(t, errors.Is(err, matrix.ErrSingular))
+	assert.ErrorContains(t, err, "zero pivot at row 1")
+}
+
+func TestIC0_ExactOnTridiagonal(t *testing.T) {
+	a := newLaplacian(6)
+	p, err := NewIC0(a)
+	require.NoError(t, err)
+	r := vector.NewFromData([]float64{1, 0, -1, 2, 0, 1})
+	expected, _ := a.ToDense().Solve(r)
+
+	require.NoError(t, p.Precondition(r, r))
+
+	assert.InDeltaSlice(t, expected.GetData(), r.GetData(), 1e-12)
+}
+
+func TestIC0_ApproximatesOnLaplacian2D(t *testing.T) {
+	a := newLaplacian2D(4)
+	p, err := NewIC0(a)
+	require.NoError(t, err)
+	r := vector.New(16)
+	r.AddScalarInPlace(1)
+	z := vector.New(16)
+
+	require.NoError(t, p.Precondition(r, z))
+
+	// M is close to A, but not equal since fill-in was dropped
+	assert.Less(t, relativeResidual(t, a, z, r), 0.5)
+	assert.Greater(t, relativeResidual(t, a, z, r), 1e-6)
+}
+
+func TestIC0_ShouldFail_NotPositiveDefinite(t *testing.T) {
+	a, _ := matrix.NewFromData([][]float64{{1, 2}, {2, 1}})
+
+	_, err := NewIC0(a)
+
+	assert.True(t, errors.Is(err, matrix.ErrNotPositiveDefinite))
+}
+
+func TestIncomplete_ReusableAcrossSolves(t *testing.T) {
+	a := newLaplacian2D(10)
+	p, err := NewIC0(a)
+	require.NoError(t, err)
+
+	for k := 0; k < 3; k++ {
+		b := vector.New(100)
+		b.SetElementAt(k*10, 1)
+
+		result, err := CG(a, b, &Settings{Preconditioner: p})
+
+		require.NoError(t, err)
+		assert.True(t, result.Converged())
+	}
+}
@@ -0,0 +1,200 @@
+package solver
+
+import (
+	"fmt"
+
+	"github.com/JoLandry/linalgo/matrix"
+	"github.com/JoLandry/linalgo/sparse"
+	"github.com/JoLandry/linalgo/vector"
+)
+
+// Preconditioner approximates the inverse of the operator of a linear system,
+// to speed up the convergence of iterative solvers.
+//
+// A preconditioner is built once from the operator, and can then be reused
+// across any number of solves. Precondition does not modify the preconditioner,
+// so it can be used concurrently.
+type Preconditioner interface {
+	// Stores M^-1 * r into z, where M approximates the operator.
+	// The vector z may be r itself.
+	Precondition(r, z *vector.Vector) error
+}
+
+// Preconditioner used when none is given, namely M = I.
+type identity struct{}
+
+func (identity) Precondition(r, z *vector.Vector) error {
+	return vector.CopyTo(z, r)
+}
+
+// Jacobi is the diagonal preconditioner M = diag(A).
+//
+// It is the cheapest preconditioner, and works well on diagonally dominant
+// matrices whose diagonal varies a lot.
+type Jacobi struct {
+	inverseDiagonal []float64
+}
+
+// SSOR is the symmetric successive over-relaxation preconditioner
+//
+//	M = w/(2-w) * (D/w + L) * (D/w)^-1 * (D/w + U)
+//
+// where D, L and U are the diagonal, strictly lower and strictly upper parts of A,
+// and w is the relaxation factor. It is symmetric when A is symmetric,
+// so it can be used with CG.
+type SSOR struct {
+	a     *rowStorage
+	omega float64
+}
+
+// Creates and returns the Jacobi preconditioner of the operator.
+//
+// The operator must be a *matrix.Matrix, a *sparse.CSR or a *sparse.CSC.
+// Returns an error if the operator is not square or if its diagonal has a zero.
+func NewJacobi(a matrix.LinearOperator) (*Jacobi, error) {
+	storage, err := newRowStorage(a)
+	if err != nil {
+		return nil, err
+	}
+
+	inverseDiagonal := make([]float64, storage.n)
+	for i := range inverseDiagonal {
+		inverseDiagonal[i] = 1.0 / storage.values[storage.diag[i]]
+	}
+
+	return &Jacobi{inverseDiagonal: inverseDiagonal}, nil
+}
+
+// Stores M^-1 * r into z. Returns an error if the dimensions do not match.
+func (p *Jacobi) Precondition(r, z *vector.Vector) error {
+	if err := checkPreconditionSizes(r, z, len(p.inverseDiagonal)); err != nil {
+		return err
+	}
+
+	rValues, zValues := r.GetData(), z.GetData()
+	for i, inv := range p.inverseDiagonal {
+		zValues[i] = rValues[i] * inv
+	}
+
+	return nil
+}
+
+// Creates and returns the SSOR preconditioner of the operator, with the relaxation factor omega.
+//
+// The operator must be a *matrix.Matrix, a *sparse.CSR or a *sparse.CSC.
+// Returns an error if the operator is not square, if its diagonal has a zero,
+// or if omega is not strictly between 0 and 2.
+func NewSSOR(a matrix.LinearOperator, omega float64) (*SSOR, error) {
+	if omega <= 0.0 || omega >= 2.0 {
+		return nil, fmt.Errorf("relaxation factor %g must be strictly between 0 and 2", omega)
+	}
+	storage, err := newRowStorage(a)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SSOR{a: storage, omega: omega}, nil
+}
+
+// Stores M^-1 * r into z. Returns an error if the dimensions do not match.
+func (p *SSOR) Precondition(r, z *vector.Vector) error {
+	a := p.a
+	if err := checkPreconditionSizes(r, z, a.n); err != nil {
+		return err
+	}
+	_ = vector.CopyTo(z, r)
+	values := z.GetData()
+
+	// Forward substitution with D/w + L, then scaling by D/w
+	for i := 0; i < a.n; i++ {
+		sum := values[i]
+		for e := a.ptr[i]; e < a.diag[i]; e++ {
+			sum -= a.values[e] * values[a.idx[e]]
+		}
+		values[i] = sum * p.omega / a.values[a.diag[i]]
+	}
+	for i := 0; i < a.n; i++ {
+		values[i] *= a.values[a.diag[i]] / p.omega
+	}
+	// Backward substitution with D/w + U, then scaling by (2-w)/w
+	for i := a.n - 1; i >= 0; i-- {
+		sum := values[i]
+		for e := a.diag[i] + 1; e < a.ptr[i+1]; e++ {
+			sum -= a.values[e] * values[a.idx[e]]
+		}
+		values[i] = sum * p.omega / a.values[a.diag[i]]
+	}
+	for i := range values {
+		values[i] *= (2.0 - p.omega) / p.omega
+	}
+
+	return nil
+}
+
+// Compressed rows of a square operator, with the position of each diagonal element.
+//
+// The elements of row i are idx[ptr[i]:ptr[i+1]] and values[ptr[i]:ptr[i+1]],
+// sorted by column, and the diagonal element of row i is at position diag[i].
+type rowStorage struct {
+	n      int
+	ptr    []int
+	idx    []int
+	values []float64
+	diag   []int
+}
+
+// Copies the rows of the operator, which must be a *matrix.Matrix, a *sparse.CSR or a *sparse.CSC.
+//
+// Returns an error if the operator is not square, if it has another type,
+// or if one of its diagonal elements is zero.
+func newRowStorage(a matrix.LinearOperator) (*rowStorage, error) {
+	var csr *sparse.CSR
+	switch op := a.(type) {
+	case *matrix.Matrix:
+		csr = sparse.NewCSRFromDense(op)
+	case *sparse.CSR:
+		csr = op
+	case *sparse.CSC:
+		csr = op.ToCSR()
+	default:
+		return nil, fmt.Errorf("unsupported operator type %T, cannot build preconditioner", a)
+	}
+	n := csr.GetNbRows()
+	if csr.GetNbCols() != n {
+		return nil, fmt.Errorf("operator is not square (%dx%d), cannot build preconditioner", n, csr.GetNbCols())
+	}
+
+	storage := &rowStorage{
+		n:      n,
+		ptr:    make([]int, n+1),
+		idx:    make([]int, 0, csr.NNZ()),
+		values: make([]float64, 0, csr.NNZ()),
+		diag:   make([]int, n),
+	}
+	for i := 0; i < n; i++ {
+		cols, values := csr.RowNonZeros(i)
+		storage.diag[i] = -1
+		for e, j := range cols {
+			if j == i && values[e] != 0.0 {
+				storage.diag[i] = len(storage.idx)
+			}
+			storage.idx = append(storage.idx, j)
+			storage.values = append(storage.values, values[e])
+		}
+		storage.ptr[i+1] = len(storage.idx)
+		if storage.diag[i] < 0 {
+			return nil, fmt.Errorf("zero diagonal element at row %d, cannot build preconditioner", i)
+		}
+	}
+
+	return storage, nil
+}
+
+// Checks that r and z both have size n.
+func checkPreconditionSizes(r, z *vector.Vector, n int) error {
+	if r.GetSize() != n || z.GetSize() != n {
+		return fmt.Errorf("mismatch between size of preconditioner (%d) and sizes of vectors (%d and %d)", n, r.GetSize(), z.GetSize())
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package solver
+
+import (
+	"testing"
+
+	"github.com/JoLandry/linalgo/matrix"
+	"github.com/JoLandry/linalgo/sparse"
+	"github.com/JoLandry/linalgo/vector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 2D Laplacian on a size x size grid (5-point stencil), symmetric positive definite
+func newLaplacian2D(size int) *sparse.CSR {
+	n := size * size
+	c := sparse.NewCOO(n, n)
+	for x := 0; x < size; x++ {
+		for y := 0; y < size; y++ {
+			i := x*size + y
+			_ = c.Append(i, i, 4)
+			if x > 0 {
+				_ = c.Append(i, i-size, -1)
+			}
+			if x < size-1 {
+				_ = c.Append(i, i+size, -1)
+			}
+			if y > 0 {
+				_ = c.Append(i, i-1, -1)
+			}
+			if y < size-1 {
+				_ = c.Append(i, i+1, -1)
+			}
+		}
+	}
+	return c.ToCSR()
+}
+
+// Operator that is neither a dense nor a sparse matrix
+type scaledIdentity struct {
+	n     int
+	scale float64
+}
+
+func (s scaledIdentity) Dims() (int, int) { return s.n, s.n }
+func (s scaledIdentity) Apply(x, y *vector.Vector) error {
+	return vector.MulScalarTo(y, x, s.scale)
+}
+
+func TestJacobi(t *testing.T) {
+	a, _ := matrix.NewFromData([][]float64{{2, 1}, {1, 4}})
+	p, err := NewJacobi(a)
+	require.NoError(t, err)
+	z := vector.New(2)
+
+	require.NoError(t, p.Precondition(vector.NewFromData([]float64{1, 2}), z))
+
+	assert.Equal(t, []float64{0.5, 0.5}, z.GetData())
+	assert.Error(t, p.Precondition(vector.New(3), z))
+}
+
+func TestJacobi_InPlace(t *testing.T) {
+	p, err := NewJacobi(sparse.NewCSCFromDense(matrix.NewDiagonal([]float64{2, 4})))
+	require.NoError(t, err)
+	r := vector.NewFromData([]float64{1, 1})
+
+	require.NoError(t, p.Precondition(r, r))
+
+	assert.Equal(t, []float64{0.5, 0.25}, r.GetData())
+}
+
+func TestPreconditioners_ShouldFail_InvalidOperator(t *testing.T) {
+	zeroDiagonal, _ := matrix.NewFromData([][]float64{{0, 1}, {1, 0}})
+
+	_, errNonSquare := NewJacobi(matrix.New(2, 3))
+	_, errDiagonal := NewILU0(zeroDiagonal)
+	_, errType := NewIC0(scaledIdentity{n: 2, scale: 2})
+
+	assert.ErrorContains(t, errNonSquare, "not square")
+	assert.ErrorContains(t, errDiagonal, "zero diagonal element at row 0")
+	assert.ErrorContains(t, errType, "unsupported operator type")
+}
+
+func TestSSOR_MatchesDefinition(t *testing.T) {
+	a, _ := matrix.NewFromData([][]float64{
+		{4, -1, 1},
+		{-1, 4, -2},
+		{1, -2, 5},
+	})
+	omega := 1.3
+	p, err := NewSSOR(a, omega)
+	require.NoError(t, err)
+
+	// M = w/(2-w) * (D/w + L) * (D/w)^-1 * (D/w + U)
+	lower, upper := matrix.New(3, 3), matrix.New(3, 3)
+	scaledDiagonal := make([]float64, 3)
+	for i := 0; i < 3; i++ {
+		scaledDiagonal[i] = a.GetElementAt(i, i) / omega
+		for j := 0; j < 3; j++ {
+			if j < i {
+				lower.SetElementAt(i, j, a.GetElementAt(i, j))
+			} else if j > i {
+				upper.SetElementAt(i, j, a.GetElementAt(i, j))
+			}
+		}
+	}
+	d := matrix.NewDiagonal(scaledDiagonal)
+	dInv, _ := d.Invert()
+	left, _ := d.Add(lower)
+	right, _ := d.Add(upper)
+	m, _ := left.Mul(dInv)
+	m, _ = m.Mul(right)
+	m = m.MulScalar(omega / (2 - omega))
+
+	r := vector.NewFromData([]float64{1, -2, 3})
+	z := vector.New(3)
+	require.NoError(t, p.Precondition(r, z))
+	mz, _ := m.MulVec(z)
+
+	assert.InDeltaSlice(t, r.GetData(), mz.GetData(), 1e-12)
+}
+
+func TestSSOR_ShouldFail_InvalidOmega(t *testing.T) {
+	_, errZero := NewSSOR(matrix.NewIdentity(2), 0)
+	_, errTwo := NewSSOR(matrix.NewIdentity(2), 2)
+
+	assert.ErrorContains(t, errZero, "strictly between 0 and 2")
+	assert.Error(t, errTwo)
+}
+
+func TestPreconditioners_SpeedUpConvergence(t *testing.T) {
+	a := newLaplacian2D(20)
+	b := vector.New(400)
+	b.AddScalarInPlace(1)
+
+	jacobi, err := NewJacobi(a)
+	require.NoError(t, err)
+	ssor, err := NewSSOR(a, 1.5)
+	require.NoError(t, err)
+	ilu, err := NewILU0(a)
+	require.NoError(t, err)
+	ic, err := NewIC0(a)
+	require.NoError(t, err)
+
+	for name, solve := range allSolvers {
+		plain, err := solve(a, b, &Settings{Tolerance: 1e-10})
+		require.NoError(t, err, name)
+		require.True(t, plain.Converged(), name)
+
+		for _, p := range []Preconditioner{jacobi, ssor, ilu, ic} {
+			result, err := solve(a, b, &Settings{Tolerance: 1e-10, Preconditioner: p})
+
+			require.NoError(t, err, "%s with %T", name, p)
+			assert.True(t, result.Converged(), "%s with %T", name, p)
+			assert.Less(t, relativeResidual(t, a, result.X, b), 1e-8, "%s with %T", name, p)
+			if _, isJacobi := p.(*Jacobi); !isJacobi {
+				// The diagonal of this matrix is constant, so Jacobi cannot help
+				assert.Less(t, result.Iterations, plain.Iterations, "%s with %T", name, p)
+			}
+		}
+	}
+}
+
+func TestPreconditioners_ShouldFail_SizeMismatchInSolver(t *testing.T) {
+	p, err := NewJacobi(matrix.NewIdentity(3))
+	require.NoError(t, err)
+
+	for name, solve := range allSolvers {
+		_, err := solve(newLaplacian(4), vector.NewFromData([]float64{1, 0, 0, 0}), &Settings{Preconditioner: p})
+
+		assert.ErrorContains(t, err, "mismatch between size of preconditioner", name)
+	}
+}
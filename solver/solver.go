@@ -0,0 +1,181 @@
+// Package solver provides iterative (Krylov subspace) methods to solve large
+// linear systems A * x = b, where A is only accessed through matrix-vector products.
+//
+// The package supports:
+//   - Conjugate Gradient (CG) for symmetric positive definite systems
+//   - Restarted GMRES for general systems
+//   - BiCGSTAB for general systems, with a fixed amount of memory
+//   - Jacobi, SSOR, ILU(0) and IC(0) preconditioners
+//
+// Each solver works on any matrix.LinearOperator, such as a dense matrix.Matrix,
+// a sparse CSR or CSC matrix, or a user-defined operator that is never
+// materialized, and reports the number of iterations, the history of the
+// residual and the reason it stopped.
+package solver
+
+import (
+	"fmt"
+
+	"github.com/JoLandry/linalgo/matrix"
+	"github.com/JoLandry/linalgo/vector"
+)
+
+// Default relative tolerance on the residual, used when Settings.Tolerance is not set.
+const DefaultTolerance = 1e-8
+
+// Settings holds the parameters of an iterative solver.
+//
+// The zero value holds the default parameters, and a nil *Settings can be used as well.
+type Settings struct {
+	// Relative tolerance: the solver stops once ||b - A * x|| <= Tolerance * ||b||.
+	// Defaults to DefaultTolerance when lower than or equal to zero.
+	Tolerance float64
+	// Maximum number of iterations. Defaults to 10 times the size of the system
+	// when lower than or equal to zero.
+	MaxIterations int
+	// Initial guess of the solution, not modified by the solver. Defaults to the zero vector.
+	InitialGuess *vector.Vector
+	// Number of iterations between two restarts of GMRES, ignored by the other solvers.
+	// Defaults to min(n, 30) when lower than or equal to zero.
+	Restart int
+	// Preconditioner applied at each iteration. Defaults to no preconditioning.
+	// The residual history always measures the residual of the original system.
+	Preconditioner Preconditioner
+}
+
+// StopReason tells why an iterative solver stopped.
+type StopReason int
+
+const (
+	// The relative residual is below the tolerance
+	Converged StopReason = iota
+	// The maximum number of iterations was reached before convergence
+	MaxIterationsReached
+	// The method cannot proceed, for instance because a division by zero would
+	// occur or because the operator does not have the properties the method requires
+	Breakdown
+)
+
+// String returns a human-readable description of the stop reason.
+func (r StopReason) String() string {
+	switch r {
+	case Converged:
+		return "converged"
+	case MaxIterationsReached:
+		return "maximum number of iterations reached"
+	case Breakdown:
+		return "breakdown"
+	default:
+		return fmt.Sprintf("StopReason(%d)", int(r))
+	}
+}
+
+// Result holds the outcome of an iterative solver.
+type Result struct {
+	// Last computed approximation of the solution
+	X *vector.Vector
+	// Number of iterations performed
+	Iterations int
+	// Relative residual norm ||b - A * x|| / ||b|| at the start and after each iteration
+	ResidualHistory []float64
+	// Why the solver stopped
+	Reason StopReason
+}
+
+// Tells whether the solver reached the requested tolerance.
+func (r *Result) Converged() bool {
+	return r.Reason == Converged
+}
+
+// Returns the last relative residual norm.
+func (r *Result) Residual() float64 {
+	return r.ResidualHistory[len(r.ResidualHistory)-1]
+}
+
+// Settings with the defaults filled in, for a system of size n.
+type resolvedSettings struct {
+	tolerance      float64
+	maxIterations  int
+	restart        int
+	preconditioner Preconditioner
+}
+
+// Checks the dimensions of the system and of the initial guess, and fills in the default settings.
+func prepare(a matrix.LinearOperator, b *vector.Vector, settings *Settings) (*resolvedSettings, *vector.Vector, error) {
+	if settings == nil {
+		settings = &Settings{}
+	}
+	n, nbCols := a.Dims()
+	if nbCols != n {
+		return nil, nil, fmt.Errorf("operator is not square (%dx%d), cannot solve iteratively", n, nbCols)
+	}
+	if b.GetSize() != n {
+		return nil, nil, fmt.Errorf("mismatch between size of operator (%d) and size of right-hand side (%d)", n, b.GetSize())
+	}
+
+	x := vector.New(n)
+	if settings.InitialGuess != nil {
+		if err := vector.CopyTo(x, settings.InitialGuess); err != nil {
+			return nil, nil, fmt.Errorf("invalid initial guess: %w", err)
+		}
+	}
+
+	resolved := &resolvedSettings{
+		tolerance:      settings.Tolerance,
+		maxIterations:  settings.MaxIterations,
+		restart:        settings.Restart,
+		preconditioner: settings.Preconditioner,
+	}
+	if resolved.tolerance <= 0.0 {
+		resolved.tolerance = DefaultTolerance
+	}
+	if resolved.maxIterations <= 0 {
+		resolved.maxIterations = 10 * n
+	}
+	if resolved.restart <= 0 {
+		resolved.restart = min(n, 30)
+	}
+	if resolved.preconditioner == nil {
+		resolved.preconditioner = identity{}
+	}
+
+	return resolved, x, nil
+}
+
+// Computes the initial residual r = b - A * x and starts the result.
+//
+// The result is already marked as converged if b is zero (the solution is then zero)
+// or if the initial guess is accurate enough.
+func initialize(a matrix.LinearOperator, b, x *vector.Vector, s *resolvedSettings) (*Result, *vector.Vector, float64, error) {
+	n := b.GetSize()
+	bNorm := b.Norm()
+	if bNorm == 0.0 {
+		return &Result{X: vector.New(n), ResidualHistory: []float64{0.0}, Reason: Converged}, vector.New(n), bNorm, nil
+	}
+
+	r := vector.New(n)
+	if err := residual(r, a, x, b); err != nil {
+		return nil, nil, 0.0, err
+	}
+	result := &Result{X: x, ResidualHistory: []float64{r.Norm() / bNorm}, Reason: MaxIterationsReached}
+	if result.Residual() <= s.tolerance {
+		result.Reason = Converged
+	}
+
+	return result, r, bNorm, nil
+}
+
+// Stores b - A * x into r, which must not be x.
+func residual(r *vector.Vector, a matrix.LinearOperator, x, b *vector.Vector) error {
+	if err := a.Apply(x, r); err != nil {
+		return err
+	}
+
+	return vector.SubTo(r, b, r)
+}
+
+// Returns the dot product of two vectors of the same dimension.
+func dot(v1, v2 *vector.Vector) float64 {
+	result, _ := vector.DotProduct(v1, v2)
+	return result
+}
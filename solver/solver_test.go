@@ -0,0 +1,173 @@
+package solver
+
+import (
+	"testing"
+
+	"github.com/JoLandry/linalgo/matrix"
+	"github.com/JoLandry/linalgo/sparse"
+	"github.com/JoLandry/linalgo/vector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type solveFunc func(matrix.LinearOperator, *vector.Vector, *Settings) (*Result, error)
+
+var allSolvers = map[string]solveFunc{
+	"CG":       CG,
+	"GMRES":    GMRES,
+	"BiCGSTAB": BiCGSTAB,
+}
+
+// 1D Laplacian with n points, symmetric positive definite
+func newLaplacian(n int) *sparse.CSR {
+	c := sparse.NewCOO(n, n)
+	for i := 0; i < n; i++ {
+		_ = c.Append(i, i, 2)
+		if i > 0 {
+			_ = c.Append(i, i-1, -1)
+			_ = c.Append(i-1, i, -1)
+		}
+	}
+	return c.ToCSR()
+}
+
+// Returns the relative residual ||b - A * x|| / ||b||
+func relativeResidual(t *testing.T, a matrix.LinearOperator, x, b *vector.Vector) float64 {
+	ax := vector.New(b.GetSize())
+	require.NoError(t, a.Apply(x, ax))
+	r, err := b.Sub(ax)
+	require.NoError(t, err)
+	return r.Norm() / b.Norm()
+}
+
+func TestSolvers_ShouldFail_InvalidDimensions(t *testing.T) {
+	for name, solve := range allSolvers {
+		_, errNonSquare := solve(matrix.New(2, 3), vector.New(2), nil)
+		_, errRhs := solve(matrix.NewIdentity(2), vector.New(3), nil)
+		_, errGuess := solve(matrix.NewIdentity(2), vector.New(2), &Settings{InitialGuess: vector.New(3)})
+
+		assert.ErrorContains(t, errNonSquare, "not square", name)
+		assert.ErrorContains(t, errRhs, "mismatch", name)
+		assert.ErrorContains(t, errGuess, "initial guess", name)
+	}
+}
+
+func TestSolvers_ZeroRightHandSide(t *testing.T) {
+	for name, solve := range allSolvers {
+		result, err := solve(newLaplacian(5), vector.New(5), &Settings{InitialGuess: vector.NewFromData([]float64{1, 2, 3, 4, 5})})
+
+		require.NoError(t, err, name)
+		assert.True(t, result.Converged(), name)
+		assert.Equal(t, 0, result.Iterations, name)
+		assert.True(t, result.X.IsZero(), name)
+	}
+}
+
+func TestSolvers_ExactInitialGuess(t *testing.T) {
+	a := newLaplacian(4)
+	expected := vector.NewFromData([]float64{1, -1, 2, 0.5})
+	b, _ := a.MulVec(expected)
+
+	for name, solve := range allSolvers {
+		result, err := solve(a, b, &Settings{InitialGuess: expected})
+
+		require.NoError(t, err, name)
+		assert.True(t, result.Converged(), name)
+		assert.Equal(t, 0, result.Iterations, name)
+		assert.Equal(t, expected.GetData(), result.X.GetData(), name)
+		// The initial guess is not modified
+		assert.Equal(t, []float64{1, -1, 2, 0.5}, expected.GetData(), name)
+	}
+}
+
+func TestSolvers_ResidualHistory(t *testing.T) {
+	a := newLaplacian(50)
+	b := vector.New(50)
+	b.AddScalarInPlace(1)
+
+	for name, solve := range allSolvers {
+		result, err := solve(a, b, &Settings{Tolerance: 1e-10})
+
+		require.NoError(t, err, name)
+		assert.True(t, result.Converged(), name)
+		assert.Len(t, result.ResidualHistory, result.Iterations+1, name)
+		assert.Equal(t, 1.0, result.ResidualHistory[0], name)
+		assert.LessOrEqual(t, result.Residual(), 1e-10, name)
+		assert.Less(t, relativeResidual(t, a, result.X, b), 1e-8, name)
+	}
+}
+
+func TestSolvers_MaxIterationsReached(t *testing.T) {
+	a := newLaplacian(100)
+	b := vector.New(100)
+	b.AddScalarInPlace(1)
+
+	for name, solve := range allSolvers {
+		result, err := solve(a, b, &Settings{MaxIterations: 3})
+
+		require.NoError(t, err, name)
+		assert.Equal(t, MaxIterationsReached, result.Reason, name)
+		assert.Equal(t, 3, result.Iterations, name)
+		assert.False(t, result.Converged(), name)
+	}
+}
+
+func TestStopReason_String(t *testing.T) {
+	assert.Equal(t, "converged", Converged.String())
+	assert.Equal(t, "maximum number of iterations reached", MaxIterationsReached.String())
+	assert.Equal(t, "breakdown", Breakdown.String())
+	assert.Equal(t, "StopReason(7)", StopReason(7).String())
+}
+
+// Matrix-free 1D Laplacian, applied with its stencil
+type laplacianStencil struct {
+	n int
+}
+
+func (l laplacianStencil) Dims() (int, int) { return l.n, l.n }
+func (l laplacianStencil) Apply(x, y *vector.Vector) error {
+	values, result := x.GetData(), y.GetData()
+	for i := range result {
+		result[i] = 2 * values[i]
+		if i > 0 {
+			result[i] -= values[i-1]
+		}
+		if i < l.n-1 {
+			result[i] -= values[i+1]
+		}
+	}
+	return nil
+}
+
+func TestSolvers_MatrixFreeOperator(t *testing.T) {
+	n := 300
+	op := laplacianStencil{n: n}
+	b := vector.New(n)
+	b.SetElementAt(n/3, 1)
+	expected, err := newLaplacian(n).ToDense().Solve(b)
+	require.NoError(t, err)
+
+	for name, solve := range allSolvers {
+		result, err := solve(op, b, &Settings{Tolerance: 1e-12, MaxIterations: 20 * n, Restart: n})
+
+		require.NoError(t, err, name)
+		assert.True(t, result.Converged(), name)
+		assert.InDeltaSlice(t, expected.GetData(), result.X.GetData(), 1e-6, name)
+	}
+}
+
+func TestSolvers_TransposedOperator(t *testing.T) {
+	a := newNonSymmetric(30)
+	b := vector.New(30)
+	b.AddScalarInPlace(1)
+	expected, err := a.Transpose().Solve(b)
+	require.NoError(t, err)
+
+	for _, op := range []matrix.LinearOperator{a.T(), sparse.NewCSRFromDense(a).T()} {
+		result, err := GMRES(op, b, &Settings{Tolerance: 1e-12})
+
+		require.NoError(t, err)
+		assert.True(t, result.Converged())
+		assert.InDeltaSlice(t, expected.GetData(), result.X.GetData(), 1e-8)
+	}
+}
@@ -0,0 +1,76 @@
+package sparse
+
+import (
+	"fmt"
+
+	"github.com/JoLandry/linalgo/matrix"
+)
+
+// COO is a sparse matrix in coordinate format, namely a list of
+// (row, column, value) triplets.
+//
+// It is meant to build a matrix element by element, before converting it to
+// the CSR or CSC format for computations. Triplets can be appended in any order,
+// and the values of duplicated positions are summed on conversion.
+type COO struct {
+	nbRows int
+	nbCols int
+	rows   []int
+	cols   []int
+	values []float64
+}
+
+// Create a new empty COO matrix with a given number of rows and columns
+func NewCOO(nbRows, nbCols int) *COO {
+	return &COO{nbRows: nbRows, nbCols: nbCols}
+}
+
+// Get the number of rows of the matrix
+func (c *COO) GetNbRows() int {
+	return c.nbRows
+}
+
+// Get the number of columns of the matrix
+func (c *COO) GetNbCols() int {
+	return c.nbCols
+}
+
+// Returns the number of stored triplets, duplicates included.
+func (c *COO) NNZ() int {
+	return len(c.values)
+}
+
+// Appends the triplet (row, col, value) to the matrix.
+//
+// If a value is already stored at this position, both are summed on conversion.
+// Returns an error if the position is out of range.
+func (c *COO) Append(row, col int, value float64) error {
+	if row < 0 || row >= c.nbRows || col < 0 || col >= c.nbCols {
+		return fmt.Errorf("position (%d, %d) out of range for a %dx%d matrix", row, col, c.nbRows, c.nbCols)
+	}
+	c.rows = append(c.rows, row)
+	c.cols = append(c.cols, col)
+	c.values = append(c.values, value)
+
+	return nil
+}
+
+// Returns the matrix in compressed sparse row format.
+func (c *COO) ToCSR() *CSR {
+	return &CSR{compress(c.nbRows, c.nbCols, c.rows, c.cols, c.values)}
+}
+
+// Returns the matrix in compressed sparse column format.
+func (c *COO) ToCSC() *CSC {
+	return &CSC{compress(c.nbCols, c.nbRows, c.cols, c.rows, c.values)}
+}
+
+// Returns the matrix as a dense matrix.
+func (c *COO) ToDense() *matrix.Matrix {
+	result := matrix.New(c.nbRows, c.nbCols)
+	for e, val := range c.values {
+		result.SetElementAt(c.rows[e], c.cols[e], result.GetElementAt(c.rows[e], c.cols[e])+val)
+	}
+
+	return result
+}
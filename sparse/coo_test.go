@@ -0,0 +1,58 @@
+package sparse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCOO_Append(t *testing.T) {
+	c := NewCOO(2, 3)
+
+	require.NoError(t, c.Append(1, 2, 4))
+	require.NoError(t, c.Append(0, 0, 1))
+
+	assert.Equal(t, 2, c.NNZ())
+	assert.Equal(t, 2, c.GetNbRows())
+	assert.Equal(t, 3, c.GetNbCols())
+}
+
+func TestCOO_Append_ShouldFail_OutOfRange(t *testing.T) {
+	c := NewCOO(2, 2)
+
+	err := c.Append(2, 0, 1)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "out of range")
+	assert.Error(t, c.Append(0, -1, 1))
+	assert.Equal(t, 0, c.NNZ())
+}
+
+func TestCOO_Conversions_SumDuplicates(t *testing.T) {
+	c := NewCOO(3, 3)
+	_ = c.Append(2, 1, 5)
+	_ = c.Append(0, 2, 1)
+	_ = c.Append(0, 0, 2)
+	_ = c.Append(2, 1, -2)
+	_ = c.Append(1, 1, 7)
+	expected := [][]float64{{2, 0, 1}, {0, 7, 0}, {0, 3, 0}}
+
+	csr := c.ToCSR()
+	csc := c.ToCSC()
+
+	assert.Equal(t, expected, c.ToDense().GetData())
+	assert.Equal(t, expected, csr.ToDense().GetData())
+	assert.Equal(t, expected, csc.ToDense().GetData())
+	assert.Equal(t, 4, csr.NNZ())
+	assert.Equal(t, 4, csc.NNZ())
+}
+
+func TestCOO_Empty(t *testing.T) {
+	c := NewCOO(2, 2)
+
+	csr := c.ToCSR()
+
+	assert.Equal(t, 0, csr.NNZ())
+	assert.True(t, csr.ToDense().IsZero())
+}
@@ -0,0 +1,168 @@
+package sparse
+
+import (
+	"fmt"
+
+	"github.com/JoLandry/linalgo/matrix"
+	"github.com/JoLandry/linalgo/vector"
+)
+
+// CSC is a sparse matrix in compressed sparse column format.
+//
+// The non-zero elements of each column are stored contiguously, sorted by row,
+// which makes column access efficient. The CSC storage of a matrix is the CSR
+// storage of its transpose.
+// A CSC matrix is immutable: operations return new matrices.
+type CSC struct {
+	c *compressed
+}
+
+// Creates and returns the CSC matrix holding the non-zero elements of a dense matrix.
+func NewCSCFromDense(m *matrix.Matrix) *CSC {
+	rows, cols, values := denseTriplets(m)
+	return &CSC{compress(m.GetNbCols(), m.GetNbRows(), cols, rows, values)}
+}
+
+// Get the number of rows of the matrix
+func (a *CSC) GetNbRows() int {
+	return a.c.nbMinor
+}
+
+// Get the number of columns of the matrix
+func (a *CSC) GetNbCols() int {
+	return a.c.nbMajor
+}
+
+// Returns the number of stored elements.
+func (a *CSC) NNZ() int {
+	return a.c.nnz()
+}
+
+// Get the element at row row and column col, zero if it is not stored
+func (a *CSC) GetElementAt(row, col int) float64 {
+	return a.c.at(col, row)
+}
+
+// Returns the row indices and the values of the stored elements of column j,
+// sorted by row.
+//
+// The returned slices share the storage of the matrix and must not be modified.
+func (a *CSC) ColNonZeros(j int) ([]int, []float64) {
+	start, end := a.c.ptr[j], a.c.ptr[j+1]
+	return a.c.idx[start:end:end], a.c.values[start:end:end]
+}
+
+// Returns the number of rows and the number of columns of the matrix.
+func (a *CSC) Dims() (int, int) {
+	return a.GetNbRows(), a.GetNbCols()
+}
+
+// Stores the product between the sparse matrix and x into y, without allocating.
+//
+// This makes the matrix a matrix.LinearOperator.
+// Returns an error if the dimensions do not agree or if y is x.
+func (a *CSC) Apply(x, y *vector.Vector) error {
+	return a.MulVecTo(y, x)
+}
+
+// Returns the transpose of the matrix as a linear operator, without copying any element.
+//
+// The CSR storage of the transpose is the same as the CSC storage of the matrix.
+func (a *CSC) T() matrix.LinearOperator {
+	return &CSR{a.c}
+}
+
+// Returns the matrix as a dense matrix.
+func (a *CSC) ToDense() *matrix.Matrix {
+	result := matrix.New(a.GetNbRows(), a.GetNbCols())
+	for j := 0; j < a.c.nbMajor; j++ {
+		for e := a.c.ptr[j]; e < a.c.ptr[j+1]; e++ {
+			result.SetElementAt(a.c.idx[e], j, a.c.values[e])
+		}
+	}
+
+	return result
+}
+
+// Returns the matrix in compressed sparse row format.
+func (a *CSC) ToCSR() *CSR {
+	return &CSR{a.c.transpose()}
+}
+
+// Returns the transpose of the matrix.
+func (a *CSC) Transpose() *CSC {
+	return &CSC{a.c.transpose()}
+}
+
+// Returns a new matrix that is the element-wise sum of a and other.
+//
+// Returns an error if the matrices do not have the same dimensions.
+func (a *CSC) Add(other *CSC) (*CSC, error) {
+	if a.GetNbRows() != other.GetNbRows() || a.GetNbCols() != other.GetNbCols() {
+		return nil, fmt.Errorf("mismatch in the number of rows or columns between matrices, cannot perform addition")
+	}
+
+	return &CSC{a.c.add(other.c)}, nil
+}
+
+// Returns a new matrix resulting from the multiplication of each element by the given scalar.
+func (a *CSC) MulScalar(scalar float64) *CSC {
+	return &CSC{a.c.scale(scalar)}
+}
+
+// Performs the product between the sparse matrix and a vector.
+//
+// Returns an error if the size of the vector does not match the number of columns.
+func (a *CSC) MulVec(v *vector.Vector) (*vector.Vector, error) {
+	result := vector.New(a.GetNbRows())
+	if err := a.MulVecTo(result, v); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Stores the product between the sparse matrix and v into dst, without allocating.
+//
+// The destination must not be v itself.
+// Returns an error if the dimensions do not agree or if dst is v.
+func (a *CSC) MulVecTo(dst, v *vector.Vector) error {
+	if err := checkMulVec(dst, v, a.GetNbRows(), a.GetNbCols()); err != nil {
+		return err
+	}
+
+	values, dstValues := v.GetData(), dst.GetData()
+	clear(dstValues)
+	for j := 0; j < a.c.nbMajor; j++ {
+		for e := a.c.ptr[j]; e < a.c.ptr[j+1]; e++ {
+			dstValues[a.c.idx[e]] += a.c.values[e] * values[j]
+		}
+	}
+
+	return nil
+}
+
+// Performs the product between the sparse matrix and a dense matrix.
+//
+// Returns an error if the number of rows of m does not match the number of columns.
+func (a *CSC) MulDense(m *matrix.Matrix) (*matrix.Matrix, error) {
+	if a.GetNbCols() != m.GetNbRows() {
+		return nil, fmt.Errorf("mismatch between number of columns of sparse matrix (%d) and number of rows of dense matrix (%d), cannot perform multiplication", a.GetNbCols(), m.GetNbRows())
+	}
+
+	// Scatter row k of m into the rows of the result, without
+	// going through the bounds-checked element accessors
+	rows, nbCols := m.GetData(), m.GetNbCols()
+	values := make([]float64, a.GetNbRows()*nbCols)
+	for k := 0; k < a.c.nbMajor; k++ {
+		for e := a.c.ptr[k]; e < a.c.ptr[k+1]; e++ {
+			i, val := a.c.idx[e], a.c.values[e]
+			resultRow := values[i*nbCols : (i+1)*nbCols]
+			for j, x := range rows[k] {
+				resultRow[j] += val * x
+			}
+		}
+	}
+
+	return matrix.NewFromFlat(a.GetNbRows(), nbCols, values), nil
+}
@@ -0,0 +1,94 @@
+package sparse
+
+import (
+	"testing"
+
+	"github.com/JoLandry/linalgo/matrix"
+	"github.com/JoLandry/linalgo/vector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSC_FromDenseRoundTrip(t *testing.T) {
+	m := newTestDense()
+
+	a := NewCSCFromDense(m)
+
+	assert.Equal(t, 5, a.NNZ())
+	assert.Equal(t, 3, a.GetNbRows())
+	assert.Equal(t, 4, a.GetNbCols())
+	assert.Equal(t, m.GetData(), a.ToDense().GetData())
+	assert.Equal(t, 1.0, a.GetElementAt(0, 3))
+	assert.Equal(t, 0.0, a.GetElementAt(1, 0))
+}
+
+func TestCSC_ColNonZeros(t *testing.T) {
+	a := NewCSCFromDense(newTestDense())
+
+	rows, values := a.ColNonZeros(2)
+
+	assert.Equal(t, []int{1, 2}, rows)
+	assert.Equal(t, []float64{2, 5}, values)
+}
+
+func TestCSC_TransposeAndToCSR(t *testing.T) {
+	m := newTestDense()
+	a := NewCSCFromDense(m)
+
+	assert.Equal(t, m.Transpose().GetData(), a.Transpose().ToDense().GetData())
+	assert.Equal(t, m.GetData(), a.ToCSR().ToDense().GetData())
+}
+
+func TestCSC_AddAndMulScalar(t *testing.T) {
+	m := newTestDense()
+	a := NewCSCFromDense(m)
+
+	sum, err := a.Add(a.MulScalar(-0.5))
+
+	require.NoError(t, err)
+	assert.Equal(t, m.MulScalar(0.5).GetData(), sum.ToDense().GetData())
+	_, err = a.Add(a.Transpose())
+	assert.Error(t, err)
+}
+
+func TestCSC_MulVec(t *testing.T) {
+	m := newTestDense()
+	v := vector.NewFromData([]float64{1, -1, 2, 3})
+	expected, _ := m.MulVec(v)
+
+	product, err := NewCSCFromDense(m).MulVec(v)
+
+	require.NoError(t, err)
+	assert.Equal(t, expected.GetData(), product.GetData())
+	_, err = NewCSCFromDense(m).MulVec(vector.New(3))
+	assert.Error(t, err)
+}
+
+func TestCSC_MulDense(t *testing.T) {
+	m := newTestDense()
+	b, _ := matrix.NewFromData([][]float64{{1, 2}, {3, 4}, {5, 6}, {7, 8}})
+	expected, _ := m.Mul(b)
+
+	product, err := NewCSCFromDense(m).MulDense(b)
+
+	require.NoError(t, err)
+	assert.Equal(t, expected.GetData(), product.GetData())
+	_, err = NewCSCFromDense(m).MulDense(m)
+	assert.Error(t, err)
+}
+
+func TestCSC_LinearOperator(t *testing.T) {
+	m := newTestDense()
+	a := NewCSCFromDense(m)
+	x := vector.NewFromData([]float64{1, -1, 2})
+	y := vector.New(4)
+	expected, _ := m.Transpose().MulVec(x)
+
+	require.NoError(t, a.T().Apply(x, y))
+	nbRows, nbCols := a.Dims()
+
+	assert.Equal(t, expected.GetData(), y.GetData())
+	assert.Equal(t, 3, nbRows)
+	assert.Equal(t, 4, nbCols)
+	assert.Error(t, a.Apply(x, y))
+}
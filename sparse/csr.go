@@ -0,0 +1,200 @@
+package sparse
+
+import (
+	"fmt"
+
+	"github.com/JoLandry/linalgo/matrix"
+	"github.com/JoLandry/linalgo/vector"
+)
+
+// CSR is a sparse matrix in compressed sparse row format.
+//
+// The non-zero elements of each row are stored contiguously, sorted by column,
+// which makes row access and matrix-vector products efficient.
+// A CSR matrix is immutable: operations return new matrices.
+type CSR struct {
+	c *compressed
+}
+
+// Creates and returns the CSR matrix holding the non-zero elements of a dense matrix.
+func NewCSRFromDense(m *matrix.Matrix) *CSR {
+	rows, cols, values := denseTriplets(m)
+	return &CSR{compress(m.GetNbRows(), m.GetNbCols(), rows, cols, values)}
+}
+
+// Returns the (row, column, value) triplets of the non-zero elements of a dense matrix.
+func denseTriplets(m *matrix.Matrix) ([]int, []int, []float64) {
+	rows, cols, values := []int{}, []int{}, []float64{}
+	for i := 0; i < m.GetNbRows(); i++ {
+		for j := 0; j < m.GetNbCols(); j++ {
+			if val := m.GetElementAt(i, j); val != 0.0 {
+				rows = append(rows, i)
+				cols = append(cols, j)
+				values = append(values, val)
+			}
+		}
+	}
+
+	return rows, cols, values
+}
+
+// Get the number of rows of the matrix
+func (a *CSR) GetNbRows() int {
+	return a.c.nbMajor
+}
+
+// Get the number of columns of the matrix
+func (a *CSR) GetNbCols() int {
+	return a.c.nbMinor
+}
+
+// Returns the number of stored elements.
+func (a *CSR) NNZ() int {
+	return a.c.nnz()
+}
+
+// Get the element at row row and column col, zero if it is not stored
+func (a *CSR) GetElementAt(row, col int) float64 {
+	return a.c.at(row, col)
+}
+
+// Returns the column indices and the values of the stored elements of row i,
+// sorted by column.
+//
+// The returned slices share the storage of the matrix and must not be modified.
+func (a *CSR) RowNonZeros(i int) ([]int, []float64) {
+	start, end := a.c.ptr[i], a.c.ptr[i+1]
+	return a.c.idx[start:end:end], a.c.values[start:end:end]
+}
+
+// Returns the number of rows and the number of columns of the matrix.
+func (a *CSR) Dims() (int, int) {
+	return a.GetNbRows(), a.GetNbCols()
+}
+
+// Stores the product between the sparse matrix and x into y, without allocating.
+//
+// This makes the matrix a matrix.LinearOperator.
+// Returns an error if the dimensions do not agree or if y is x.
+func (a *CSR) Apply(x, y *vector.Vector) error {
+	return a.MulVecTo(y, x)
+}
+
+// Returns the transpose of the matrix as a linear operator, without copying any element.
+//
+// The CSC storage of the transpose is the same as the CSR storage of the matrix.
+func (a *CSR) T() matrix.LinearOperator {
+	return &CSC{a.c}
+}
+
+// Returns the matrix as a dense matrix.
+func (a *CSR) ToDense() *matrix.Matrix {
+	result := matrix.New(a.GetNbRows(), a.GetNbCols())
+	for i := 0; i < a.c.nbMajor; i++ {
+		for e := a.c.ptr[i]; e < a.c.ptr[i+1]; e++ {
+			result.SetElementAt(i, a.c.idx[e], a.c.values[e])
+		}
+	}
+
+	return result
+}
+
+// Returns the matrix in compressed sparse column format.
+func (a *CSR) ToCSC() *CSC {
+	return &CSC{a.c.transpose()}
+}
+
+// Returns the transpose of the matrix.
+func (a *CSR) Transpose() *CSR {
+	return &CSR{a.c.transpose()}
+}
+
+// Returns a new matrix that is the element-wise sum of a and other.
+//
+// Returns an error if the matrices do not have the same dimensions.
+func (a *CSR) Add(other *CSR) (*CSR, error) {
+	if a.GetNbRows() != other.GetNbRows() || a.GetNbCols() != other.GetNbCols() {
+		return nil, fmt.Errorf("mismatch in the number of rows or columns between matrices, cannot perform addition")
+	}
+
+	return &CSR{a.c.add(other.c)}, nil
+}
+
+// Returns a new matrix resulting from the multiplication of each element by the given scalar.
+func (a *CSR) MulScalar(scalar float64) *CSR {
+	return &CSR{a.c.scale(scalar)}
+}
+
+// Performs the product between the sparse matrix and a vector.
+//
+// Returns an error if the size of the vector does not match the number of columns.
+func (a *CSR) MulVec(v *vector.Vector) (*vector.Vector, error) {
+	result := vector.New(a.GetNbRows())
+	if err := a.MulVecTo(result, v); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Stores the product between the sparse matrix and v into dst, without allocating.
+//
+// The destination must not be v itself.
+// Returns an error if the dimensions do not agree or if dst is v.
+func (a *CSR) MulVecTo(dst, v *vector.Vector) error {
+	if err := checkMulVec(dst, v, a.GetNbRows(), a.GetNbCols()); err != nil {
+		return err
+	}
+
+	values, dstValues := v.GetData(), dst.GetData()
+	for i := range dstValues {
+		sum := 0.0
+		for e := a.c.ptr[i]; e < a.c.ptr[i+1]; e++ {
+			sum += a.c.values[e] * values[a.c.idx[e]]
+		}
+		dstValues[i] = sum
+	}
+
+	return nil
+}
+
+// Performs the product between the sparse matrix and a dense matrix.
+//
+// Returns an error if the number of rows of m does not match the number of columns.
+func (a *CSR) MulDense(m *matrix.Matrix) (*matrix.Matrix, error) {
+	if a.GetNbCols() != m.GetNbRows() {
+		return nil, fmt.Errorf("mismatch between number of columns of sparse matrix (%d) and number of rows of dense matrix (%d), cannot perform multiplication", a.GetNbCols(), m.GetNbRows())
+	}
+
+	// Accumulate row i of the result from the rows of m, without
+	// going through the bounds-checked element accessors
+	rows, nbCols := m.GetData(), m.GetNbCols()
+	values := make([]float64, a.GetNbRows()*nbCols)
+	for i := 0; i < a.c.nbMajor; i++ {
+		resultRow := values[i*nbCols : (i+1)*nbCols]
+		for e := a.c.ptr[i]; e < a.c.ptr[i+1]; e++ {
+			val := a.c.values[e]
+			for j, x := range rows[a.c.idx[e]] {
+				resultRow[j] += val * x
+			}
+		}
+	}
+
+	return matrix.NewFromFlat(a.GetNbRows(), nbCols, values), nil
+}
+
+// Checks the dimensions of a sparse matrix-vector product, and that dst is not v.
+func checkMulVec(dst, v *vector.Vector, nbRows, nbCols int) error {
+	if v.GetSize() != nbCols {
+		return fmt.Errorf("mismatch between number of columns of matrix (%d) and size of vector (%d), cannot perform multiplication", nbCols, v.GetSize())
+	}
+	if dst.GetSize() != nbRows {
+		return fmt.Errorf("destination of size %d cannot hold the result of size %d, cannot perform multiplication", dst.GetSize(), nbRows)
+	}
+	values, dstValues := v.GetData(), dst.GetData()
+	if len(values) > 0 && len(dstValues) > 0 && &values[0] == &dstValues[0] {
+		return fmt.Errorf("destination is the multiplied vector, cannot perform multiplication")
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+package sparse
+
+import (
+	"testing"
+
+	"github.com/JoLandry/linalgo/matrix"
+	"github.com/JoLandry/linalgo/vector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDense() *matrix.Matrix {
+	m, _ := matrix.NewFromData([][]float64{
+		{4, 0, 0, 1},
+		{0, 0, 2, 0},
+		{3, 0, 5, 0},
+	})
+	return m
+}
+
+// 1D Laplacian with n points
+func newLaplacian(n int) *CSR {
+	c := NewCOO(n, n)
+	for i := 0; i < n; i++ {
+		_ = c.Append(i, i, 2)
+		if i > 0 {
+			_ = c.Append(i, i-1, -1)
+			_ = c.Append(i-1, i, -1)
+		}
+	}
+	return c.ToCSR()
+}
+
+func TestCSR_FromDenseRoundTrip(t *testing.T) {
+	m := newTestDense()
+
+	a := NewCSRFromDense(m)
+
+	assert.Equal(t, 5, a.NNZ())
+	assert.Equal(t, 3, a.GetNbRows())
+	assert.Equal(t, 4, a.GetNbCols())
+	assert.Equal(t, m.GetData(), a.ToDense().GetData())
+	assert.Equal(t, 5.0, a.GetElementAt(2, 2))
+	assert.Equal(t, 0.0, a.GetElementAt(1, 3))
+}
+
+func TestCSR_RowNonZeros(t *testing.T) {
+	a := NewCSRFromDense(newTestDense())
+
+	cols, values := a.RowNonZeros(2)
+
+	assert.Equal(t, []int{0, 2}, cols)
+	assert.Equal(t, []float64{3, 5}, values)
+}
+
+func TestCSR_TransposeAndToCSC(t *testing.T) {
+	m := newTestDense()
+	a := NewCSRFromDense(m)
+
+	assert.Equal(t, m.Transpose().GetData(), a.Transpose().ToDense().GetData())
+	assert.Equal(t, m.GetData(), a.ToCSC().ToDense().GetData())
+}
+
+func TestCSR_Add(t *testing.T) {
+	m := newTestDense()
+	other, _ := matrix.NewFromData([][]float64{
+		{-4, 1, 0, 0},
+		{0, 0, 0, 0},
+		{0, 0, 1, 6},
+	})
+	expected, _ := m.Add(other)
+
+	sum, err := NewCSRFromDense(m).Add(NewCSRFromDense(other))
+
+	require.NoError(t, err)
+	assert.Equal(t, expected.GetData(), sum.ToDense().GetData())
+}
+
+func TestCSR_Add_ShouldFail_DimensionMismatch(t *testing.T) {
+	_, err := newLaplacian(3).Add(newLaplacian(4))
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot perform addition")
+}
+
+func TestCSR_MulScalar(t *testing.T) {
+	a := NewCSRFromDense(newTestDense())
+
+	scaled := a.MulScalar(2)
+
+	assert.Equal(t, newTestDense().MulScalar(2).GetData(), scaled.ToDense().GetData())
+	// The original matrix is not modified
+	assert.Equal(t, 4.0, a.GetElementAt(0, 0))
+}
+
+func TestCSR_MulVec(t *testing.T) {
+	a := newLaplacian(4)
+	v := vector.NewFromData([]float64{1, 2, 3, 4})
+
+	product, err := a.MulVec(v)
+
+	require.NoError(t, err)
+	assert.Equal(t, []float64{0, 0, 0, 5}, product.GetData())
+	_, err = a.MulVec(vector.New(3))
+	assert.Error(t, err)
+	assert.Error(t, a.MulVecTo(v, v))
+}
+
+func TestCSR_MulDense(t *testing.T) {
+	m := newTestDense()
+	b, _ := matrix.NewFromData([][]float64{{1, 2}, {3, 4}, {5, 6}, {7, 8}})
+	expected, _ := m.Mul(b)
+
+	product, err := NewCSRFromDense(m).MulDense(b)
+
+	require.NoError(t, err)
+	assert.Equal(t, expected.GetData(), product.GetData())
+	_, err = NewCSRFromDense(m).MulDense(m)
+	assert.Error(t, err)
+}
+
+func TestCSR_MulDense_View(t *testing.T) {
+	m := newTestDense()
+	// Strided view of a larger matrix
+	big := matrix.NewFromFlat(5, 3, []float64{
+		9, 9, 9,
+		1, 2, 9,
+		3, 4, 9,
+		5, 6, 9,
+		7, 8, 9,
+	})
+	b, _ := big.Slice(1, 5, 0, 2)
+	expected, _ := m.Mul(b)
+
+	csrProduct, err := NewCSRFromDense(m).MulDense(b)
+	require.NoError(t, err)
+	cscProduct, err := NewCSCFromDense(m).MulDense(b)
+	require.NoError(t, err)
+
+	assert.Equal(t, expected.GetData(), csrProduct.GetData())
+	assert.Equal(t, expected.GetData(), cscProduct.GetData())
+}
+
+func TestCSR_LargeLaplacian(t *testing.T) {
+	n := 100000
+	a := newLaplacian(n)
+	ones := vector.New(n)
+	ones.AddScalarInPlace(1)
+
+	product, err := a.MulVec(ones)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3*n-2, a.NNZ())
+	assert.Equal(t, 1.0, product.GetElementAt(0))
+	assert.Equal(t, 0.0, product.GetElementAt(n/2))
+	assert.Equal(t, 1.0, product.GetElementAt(n-1))
+}
+
+func TestCSR_LinearOperator(t *testing.T) {
+	m := newTestDense()
+	var op matrix.LinearOperator = NewCSRFromDense(m)
+	x := vector.NewFromData([]float64{1, 2, 3, 4})
+	y := vector.New(3)
+	expected, _ := m.MulVec(x)
+
+	nbRows, nbCols := op.Dims()
+	require.NoError(t, op.Apply(x, y))
+
+	assert.Equal(t, 3, nbRows)
+	assert.Equal(t, 4, nbCols)
+	assert.Equal(t, expected.GetData(), y.GetData())
+}
+
+func TestCSR_T(t *testing.T) {
+	m := newTestDense()
+	op := NewCSRFromDense(m).T()
+	x := vector.NewFromData([]float64{1, -1, 2})
+	y := vector.New(4)
+	expected, _ := m.Transpose().MulVec(x)
+
+	nbRows, nbCols := op.Dims()
+	require.NoError(t, op.Apply(x, y))
+
+	assert.Equal(t, 4, nbRows)
+	assert.Equal(t, 3, nbCols)
+	assert.Equal(t, expected.GetData(), y.GetData())
+}
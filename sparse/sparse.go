@@ -0,0 +1,161 @@
+// Package sparse provides sparse matrices, which only store their non-zero
+// elements, for large matrices that are mostly made of zeros such as
+// finite-difference or graph matrices.
+//
+// The package supports:
+//   - A coordinate (COO) format, convenient to build a matrix element by element
+//   - Compressed sparse row (CSR) and column (CSC) formats, efficient for computations
+//   - Conversions between these formats and to and from the dense matrix.Matrix
+//   - Sparse times dense matrix, and sparse times vector.Vector products
+//   - Transpose, addition and scalar multiplication
+package sparse
+
+import "sort"
+
+// Storage shared by the CSR and CSC formats.
+//
+// Elements are grouped by major index (rows for CSR, columns for CSC): the minor
+// indices and values of the elements with major index k are idx[ptr[k]:ptr[k+1]]
+// and values[ptr[k]:ptr[k+1]], sorted by increasing minor index, without duplicates.
+type compressed struct {
+	nbMajor int
+	nbMinor int
+	ptr     []int
+	idx     []int
+	values  []float64
+}
+
+// Builds the compressed storage of a list of (major, minor, value) triplets.
+//
+// Triplets may come in any order; the values of duplicated positions are summed.
+// The given slices are not modified.
+func compress(nbMajor, nbMinor int, major, minor []int, values []float64) *compressed {
+	ptr := make([]int, nbMajor+1)
+	for _, k := range major {
+		ptr[k+1]++
+	}
+	for k := 0; k < nbMajor; k++ {
+		ptr[k+1] += ptr[k]
+	}
+
+	// Counting sort on the major index
+	idx := make([]int, len(values))
+	vals := make([]float64, len(values))
+	next := make([]int, nbMajor)
+	copy(next, ptr[:nbMajor])
+	for e, k := range major {
+		idx[next[k]] = minor[e]
+		vals[next[k]] = values[e]
+		next[k]++
+	}
+
+	// Sort each group on the minor index and merge duplicates, compacting in place
+	write := 0
+	for k := 0; k < nbMajor; k++ {
+		start, end := ptr[k], ptr[k+1]
+		sort.Sort(byIndex{idx: idx[start:end], values: vals[start:end]})
+		ptr[k] = write
+		for e := start; e < end; e++ {
+			if write > ptr[k] && idx[write-1] == idx[e] {
+				vals[write-1] += vals[e]
+				continue
+			}
+			idx[write] = idx[e]
+			vals[write] = vals[e]
+			write++
+		}
+	}
+	ptr[nbMajor] = write
+
+	return &compressed{
+		nbMajor: nbMajor,
+		nbMinor: nbMinor,
+		ptr:     ptr,
+		idx:     idx[:write:write],
+		values:  vals[:write:write],
+	}
+}
+
+// Sorts indices and their values together, by increasing index.
+type byIndex struct {
+	idx    []int
+	values []float64
+}
+
+func (b byIndex) Len() int           { return len(b.idx) }
+func (b byIndex) Less(i, j int) bool { return b.idx[i] < b.idx[j] }
+func (b byIndex) Swap(i, j int) {
+	b.idx[i], b.idx[j] = b.idx[j], b.idx[i]
+	b.values[i], b.values[j] = b.values[j], b.values[i]
+}
+
+// Returns the number of stored elements.
+func (c *compressed) nnz() int {
+	return len(c.values)
+}
+
+// Returns the element at the given major and minor indices, zero if it is not stored.
+func (c *compressed) at(major, minor int) float64 {
+	start, end := c.ptr[major], c.ptr[major+1]
+	pos := start + sort.SearchInts(c.idx[start:end], minor)
+	if pos < end && c.idx[pos] == minor {
+		return c.values[pos]
+	}
+
+	return 0.0
+}
+
+// Returns the same elements, grouped by minor index instead of major index.
+func (c *compressed) transpose() *compressed {
+	major := make([]int, c.nnz())
+	for k := 0; k < c.nbMajor; k++ {
+		for e := c.ptr[k]; e < c.ptr[k+1]; e++ {
+			major[e] = k
+		}
+	}
+
+	return compress(c.nbMinor, c.nbMajor, c.idx, major, c.values)
+}
+
+// Returns the element-wise sum of two storages of the same dimensions,
+// merging the sorted groups of both.
+func (c *compressed) add(other *compressed) *compressed {
+	ptr := make([]int, c.nbMajor+1)
+	idx := make([]int, 0, c.nnz()+other.nnz())
+	values := make([]float64, 0, c.nnz()+other.nnz())
+	for k := 0; k < c.nbMajor; k++ {
+		e, f := c.ptr[k], other.ptr[k]
+		for e < c.ptr[k+1] || f < other.ptr[k+1] {
+			switch {
+			case f == other.ptr[k+1] || (e < c.ptr[k+1] && c.idx[e] < other.idx[f]):
+				idx = append(idx, c.idx[e])
+				values = append(values, c.values[e])
+				e++
+			case e == c.ptr[k+1] || other.idx[f] < c.idx[e]:
+				idx = append(idx, other.idx[f])
+				values = append(values, other.values[f])
+				f++
+			default:
+				idx = append(idx, c.idx[e])
+				values = append(values, c.values[e]+other.values[f])
+				e++
+				f++
+			}
+		}
+		ptr[k+1] = len(values)
+	}
+
+	return &compressed{nbMajor: c.nbMajor, nbMinor: c.nbMinor, ptr: ptr, idx: idx, values: values}
+}
+
+// Returns a copy of the storage with all values multiplied by the given scalar.
+//
+// The index slices are shared, since they are never modified once built.
+func (c *compressed) scale(scalar float64) *compressed {
+	values := make([]float64, len(c.values))
+	for e, val := range c.values {
+		values[e] = val * scalar
+	}
+
+	return &compressed{nbMajor: c.nbMajor, nbMinor: c.nbMinor, ptr: c.ptr, idx: c.idx, values: values}
+}
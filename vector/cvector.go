@@ -0,0 +1,231 @@
+package vector
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"strings"
+)
+
+// CVector type with dynamic size (dimension), holding complex128 values
+type CVector struct {
+	data []complex128
+	dim  int
+}
+
+// String returns a human-readable string representation of the complex vector.
+func (v *CVector) String() string {
+	if v.dim == 0 {
+		return "[]"
+	}
+
+	var builder strings.Builder
+	builder.WriteString("[")
+	for i := 0; i < v.dim; i++ {
+		builder.WriteString(fmt.Sprintf("%.4f", v.data[i]))
+		if i < v.dim-1 {
+			builder.WriteString(", ")
+		}
+	}
+	builder.WriteString("]")
+
+	return builder.String()
+}
+
+// Get the data of the complex vector
+func (v *CVector) GetData() []complex128 {
+	return v.data
+}
+
+// Get the dimension of the complex vector
+func (v *CVector) GetSize() int {
+	return v.dim
+}
+
+// Get the element at index idx
+func (v *CVector) GetElementAt(idx int) complex128 {
+	return v.data[idx]
+}
+
+// Set the element called elt at index idx
+func (v *CVector) SetElementAt(idx int, elt complex128) {
+	v.data[idx] = elt
+}
+
+// Create a new complex vector of a given dimension
+func NewCVector(vSize int) *CVector {
+	return &CVector{data: make([]complex128, vSize), dim: vSize}
+}
+
+// Create a new complex vector from a given slice (deep copy)
+func NewCVectorFromData(vData []complex128) *CVector {
+	copiedVector := make([]complex128, len(vData))
+	copy(copiedVector, vData)
+
+	return &CVector{
+		data: copiedVector,
+		dim:  len(copiedVector),
+	}
+}
+
+// Create a new complex vector with the elements of a real vector as real parts
+func NewCVectorFromReal(v *Vector) *CVector {
+	result := NewCVector(v.dim)
+	for i, val := range v.data {
+		result.data[i] = complex(val, 0)
+	}
+
+	return result
+}
+
+// Tells if 2 complex vectors are "equal"
+//
+// Considered equal if they're of the same dimension
+// And contain the same elements in the same order
+func (v *CVector) Equals(v2 *CVector) bool {
+	if v.dim != v2.dim {
+		return false
+	}
+	for i := 0; i < v.dim; i++ {
+		if v.data[i] != v2.data[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns a new complex vector resulting from the element-wise addition
+// of the calling vector and the input vector v2.
+//
+// Both vectors must have the same dimension. If their dimensions differ,
+// an error is returned.
+func (v *CVector) Add(v2 *CVector) (*CVector, error) {
+	if v.dim != v2.dim {
+		return nil, fmt.Errorf("cannot add vectors of different dimensions: %d vs %d", v.dim, v2.dim)
+	}
+
+	result := NewCVector(v.dim)
+	for i := range v.data {
+		result.data[i] = v.data[i] + v2.data[i]
+	}
+
+	return result, nil
+}
+
+// Returns a new complex vector resulting from the element-wise subtraction
+// of the input vector v2 from the calling vector.
+//
+// Both vectors must have the same dimension. If their dimensions differ,
+// an error is returned.
+func (v *CVector) Sub(v2 *CVector) (*CVector, error) {
+	if v.dim != v2.dim {
+		return nil, fmt.Errorf("cannot sub vectors of different dimensions: %d vs %d", v.dim, v2.dim)
+	}
+
+	result := NewCVector(v.dim)
+	for i := range v.data {
+		result.data[i] = v.data[i] - v2.data[i]
+	}
+
+	return result, nil
+}
+
+// Returns a new complex vector resulting from the element-wise
+// multiplication of the calling vector and the given scalar value.
+func (v *CVector) MulScalar(scalar complex128) *CVector {
+	result := NewCVectorFromData(v.data)
+	for i := range result.data {
+		result.data[i] *= scalar
+	}
+
+	return result
+}
+
+// Returns a new complex vector holding the complex conjugates
+// of the elements of the calling vector.
+func (v *CVector) Conj() *CVector {
+	result := NewCVector(v.dim)
+	for i, val := range v.data {
+		result.data[i] = cmplx.Conj(val)
+	}
+
+	return result
+}
+
+// Returns the real parts of the elements of the complex vector, as a real vector.
+func (v *CVector) Real() *Vector {
+	result := New(v.dim)
+	for i, val := range v.data {
+		result.data[i] = real(val)
+	}
+
+	return result
+}
+
+// Returns the imaginary parts of the elements of the complex vector, as a real vector.
+func (v *CVector) Imag() *Vector {
+	result := New(v.dim)
+	for i, val := range v.data {
+		result.data[i] = imag(val)
+	}
+
+	return result
+}
+
+// Computes and returns the (Euclidean) norm of the calling complex vector,
+// namely the square root of the sum of the squared moduli of its elements.
+func (v *CVector) Norm() float64 {
+	sumSquares := 0.0
+	for _, val := range v.data {
+		sumSquares += real(val)*real(val) + imag(val)*imag(val)
+	}
+
+	return math.Sqrt(sumSquares)
+}
+
+// Returns true if the calling complex vector is the zero vector
+func (v *CVector) IsZero() bool {
+	for _, val := range v.data {
+		if val != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Computes and returns the complex dot product (inner product) of two complex vectors.
+//
+// The product is conjugate-linear in its first argument and linear in its second:
+//
+//	<v1, v2> = sum(conj(v1_i) * v2_i)
+//
+// so that <v, v> is the squared norm of v, a non-negative real number.
+//
+// Returns an error if the vectors have different dimensions.
+func CDotProduct(v1 *CVector, v2 *CVector) (complex128, error) {
+	if v1.dim != v2.dim {
+		return 0, fmt.Errorf("cannot compute dot products for vectors of different dimensions: %d vs %d", v1.dim, v2.dim)
+	}
+	var sum complex128
+	for i := 0; i < v1.dim; i++ {
+		sum += cmplx.Conj(v1.data[i]) * v2.data[i]
+	}
+
+	return sum, nil
+}
+
+// Returns true if two complex vectors are approximately equal, namely
+// if they have the same dimension and the modulus of the difference of each pair
+// of corresponding elements is no more than epsilon.
+func (v *CVector) EqualsApprox(v2 *CVector, epsilon float64) bool {
+	if v.dim != v2.dim {
+		return false
+	}
+	for i := 0; i < v.dim; i++ {
+		if cmplx.Abs(v.data[i]-v2.data[i]) > epsilon {
+			return false
+		}
+	}
+	return true
+}
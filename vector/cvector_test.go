@@ -0,0 +1,110 @@
+package vector
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCVector(t *testing.T) {
+	v := NewCVector(2)
+
+	assert.Equal(t, 2, v.GetSize())
+	assert.Equal(t, []complex128{0, 0}, v.GetData())
+}
+
+func TestNewCVectorFromData_DeepCopy(t *testing.T) {
+	data := []complex128{1 + 2i, 3}
+	v := NewCVectorFromData(data)
+	data[0] = 0
+
+	assert.Equal(t, 1+2i, v.GetElementAt(0))
+	v.SetElementAt(1, -1i)
+	assert.Equal(t, -1i, v.GetElementAt(1))
+}
+
+func TestNewCVectorFromReal(t *testing.T) {
+	v := NewCVectorFromReal(NewFromData([]float64{1, -2}))
+
+	assert.Equal(t, []complex128{1, -2}, v.GetData())
+}
+
+func TestCVector_String(t *testing.T) {
+	assert.Equal(t, "[]", NewCVector(0).String())
+	assert.Equal(t, "[(1.0000+2.0000i), (0.0000-1.0000i)]", NewCVectorFromData([]complex128{1 + 2i, -1i}).String())
+}
+
+func TestCVector_AddSub(t *testing.T) {
+	v1 := NewCVectorFromData([]complex128{1 + 1i, 2})
+	v2 := NewCVectorFromData([]complex128{1i, -1 + 3i})
+
+	sum, err := v1.Add(v2)
+	require.NoError(t, err)
+	diff, err := v1.Sub(v2)
+	require.NoError(t, err)
+
+	assert.True(t, sum.Equals(NewCVectorFromData([]complex128{1 + 2i, 1 + 3i})))
+	assert.True(t, diff.Equals(NewCVectorFromData([]complex128{1, 3 - 3i})))
+}
+
+func TestCVector_AddSub_ShouldFail_DimensionMismatch(t *testing.T) {
+	v1 := NewCVector(2)
+	v2 := NewCVector(3)
+
+	_, err := v1.Add(v2)
+	assert.Error(t, err)
+	_, err = v1.Sub(v2)
+	assert.Error(t, err)
+	_, err = CDotProduct(v1, v2)
+	assert.Error(t, err)
+}
+
+func TestCVector_MulScalarAndConj(t *testing.T) {
+	v := NewCVectorFromData([]complex128{1 + 1i, 2})
+
+	assert.True(t, v.MulScalar(1i).Equals(NewCVectorFromData([]complex128{-1 + 1i, 2i})))
+	assert.True(t, v.Conj().Equals(NewCVectorFromData([]complex128{1 - 1i, 2})))
+	assert.Equal(t, []float64{1, 2}, v.Real().GetData())
+	assert.Equal(t, []float64{1, 0}, v.Imag().GetData())
+}
+
+func TestCVector_Norm(t *testing.T) {
+	v := NewCVectorFromData([]complex128{3 + 4i, 1i})
+
+	assert.InDelta(t, math.Sqrt(26), v.Norm(), 1e-12)
+	assert.Equal(t, 0.0, NewCVector(0).Norm())
+}
+
+func TestCVector_IsZero(t *testing.T) {
+	assert.True(t, NewCVector(3).IsZero())
+	assert.False(t, NewCVectorFromData([]complex128{0, 1i}).IsZero())
+}
+
+func TestCDotProduct_IsConjugateLinear(t *testing.T) {
+	v1 := NewCVectorFromData([]complex128{1i, 2})
+	v2 := NewCVectorFromData([]complex128{1, 1 + 1i})
+
+	dot, err := CDotProduct(v1, v2)
+	require.NoError(t, err)
+	// conj(i) * 1 + 2 * (1 + i)
+	assert.Equal(t, 2+1i, dot)
+
+	scaled, err := CDotProduct(v1.MulScalar(1i), v2)
+	require.NoError(t, err)
+	assert.Equal(t, -1i*dot, scaled)
+
+	self, err := CDotProduct(v1, v1)
+	require.NoError(t, err)
+	assert.Equal(t, complex(5, 0), self)
+}
+
+func TestCVector_EqualsApprox(t *testing.T) {
+	v1 := NewCVectorFromData([]complex128{1 + 1i})
+	v2 := NewCVectorFromData([]complex128{1 + 1.0000001i})
+
+	assert.True(t, v1.EqualsApprox(v2, 1e-6))
+	assert.False(t, v1.EqualsApprox(v2, 1e-9))
+	assert.False(t, v1.EqualsApprox(NewCVector(2), 1e-6))
+}
@@ -0,0 +1,36 @@
+package vector
+
+import "math"
+
+// Float is the set of element types supported by vectors and matrices.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Tells whether T is a single precision type.
+func isSingle[T Float]() bool {
+	// 1 + 2^-52 is rounded to 1 in single precision only
+	return float64(T(1+0x1p-52)) == 1
+}
+
+// Returns the machine epsilon of T, namely the distance between 1 and
+// the next larger number representable in T.
+func Epsilon[T Float]() float64 {
+	if isSingle[T]() {
+		return 0x1p-23
+	}
+	return 0x1p-52
+}
+
+// Returns the tolerance for elements of type T equivalent to the tolerance tol,
+// given for float64 elements.
+//
+// The tolerance is scaled so that it keeps the same fraction of the significant
+// digits of T: it is tol itself for float64, and for instance 1e-10 becomes
+// about 3.7e-5 for float32.
+func Tolerance[T Float](tol float64) float64 {
+	if !isSingle[T]() {
+		return tol
+	}
+	return math.Pow(tol, math.Log(Epsilon[T]())/math.Log(Epsilon[float64]()))
+}
@@ -0,0 +1,63 @@
+package vector
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type celsius float32
+
+func TestEpsilon(t *testing.T) {
+	assert.Equal(t, math.Nextafter(1, 2)-1, Epsilon[float64]())
+	assert.Equal(t, float64(math.Nextafter32(1, 2)-1), Epsilon[float32]())
+	assert.Equal(t, Epsilon[float32](), Epsilon[celsius]())
+}
+
+func TestTolerance(t *testing.T) {
+	assert.Equal(t, 1e-10, Tolerance[float64](1e-10))
+
+	single := Tolerance[float32](1e-10)
+	assert.Greater(t, single, 1e-10)
+	assert.Less(t, single, 1e-4)
+	assert.Greater(t, single, 100*Epsilon[float32]())
+	// Looser float64 tolerances stay looser
+	assert.Greater(t, Tolerance[float32](1e-9), single)
+}
+
+func TestVector32_Operations(t *testing.T) {
+	v1 := NewFromDataOf([]float32{3, 4})
+	v2 := NewOf[float32](2)
+	v2.SetElementAt(0, 1)
+
+	sum, err := v1.Add(v2)
+	require.NoError(t, err)
+	dot, err := DotProduct(v1, v2)
+	require.NoError(t, err)
+
+	assert.Equal(t, []float32{4, 4}, sum.GetData())
+	assert.Equal(t, float32(3), dot)
+	assert.Equal(t, float32(5), v1.Norm())
+	assert.Equal(t, []float32{0.6, 0.8}, v1.Normalize().GetData())
+	require.NoError(t, v1.AddInPlace(v2))
+	assert.Equal(t, []float32{4, 4}, v1.GetData())
+}
+
+func TestAreColinear_Float32UsesScaledTolerance(t *testing.T) {
+	v1 := NewFromDataOf([]float32{0.1, 0.3})
+	v2 := v1.MulScalar(3)
+
+	assert.True(t, AreColinear(v1, v2))
+}
+
+func TestConvert(t *testing.T) {
+	v := NewFromData([]float64{1.5, -2})
+
+	single := Convert[float32](v)
+	double := Convert[float64](single)
+
+	assert.Equal(t, []float32{1.5, -2}, single.GetData())
+	assert.True(t, double.Equals(v))
+}
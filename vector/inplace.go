@@ -0,0 +1,177 @@
+package vector
+
+import "fmt"
+
+// Checks that a and b have the same dimension, and that dst can hold the result
+// of an element-wise operation between them.
+//
+// The operation is used to build the error message.
+func checkElementWise[T Float](dst, a, b *VectorOf[T], operation string) error {
+	if a.dim != b.dim {
+		return fmt.Errorf("cannot %s vectors of different dimensions: %d vs %d", operation, a.dim, b.dim)
+	}
+	if dst.dim != a.dim {
+		return fmt.Errorf("destination of dimension %d cannot hold the result of dimension %d, cannot %s vectors", dst.dim, a.dim, operation)
+	}
+
+	return nil
+}
+
+// Stores the element-wise sum of a and b into dst, without allocating.
+//
+// The destination may be a or b itself.
+// Returns an error if the dimensions of a, b and dst differ.
+func AddTo[T Float](dst, a, b *VectorOf[T]) error {
+	if err := checkElementWise(dst, a, b, "add"); err != nil {
+		return err
+	}
+	for i := range dst.data {
+		dst.data[i] = a.data[i] + b.data[i]
+	}
+
+	return nil
+}
+
+// Stores the element-wise difference a - b into dst, without allocating.
+//
+// The destination may be a or b itself.
+// Returns an error if the dimensions of a, b and dst differ.
+func SubTo[T Float](dst, a, b *VectorOf[T]) error {
+	if err := checkElementWise(dst, a, b, "sub"); err != nil {
+		return err
+	}
+	for i := range dst.data {
+		dst.data[i] = a.data[i] - b.data[i]
+	}
+
+	return nil
+}
+
+// Stores the element-wise product of a and b into dst, without allocating.
+//
+// The destination may be a or b itself.
+// Returns an error if the dimensions of a, b and dst differ.
+func MulTo[T Float](dst, a, b *VectorOf[T]) error {
+	if err := checkElementWise(dst, a, b, "multiply"); err != nil {
+		return err
+	}
+	for i := range dst.data {
+		dst.data[i] = a.data[i] * b.data[i]
+	}
+
+	return nil
+}
+
+// Stores the element-wise division a / b into dst, without allocating.
+//
+// The destination may be a or b itself.
+// Returns an error if the dimensions of a, b and dst differ.
+func DivTo[T Float](dst, a, b *VectorOf[T]) error {
+	if err := checkElementWise(dst, a, b, "divide"); err != nil {
+		return err
+	}
+	for i := range dst.data {
+		dst.data[i] = a.data[i] / b.data[i]
+	}
+
+	return nil
+}
+
+// Stores a * scalar into dst, without allocating.
+//
+// The destination may be a itself.
+// Returns an error if the dimensions of a and dst differ.
+func MulScalarTo[T Float](dst, a *VectorOf[T], scalar T) error {
+	if dst.dim != a.dim {
+		return fmt.Errorf("destination of dimension %d cannot hold the result of dimension %d, cannot scale vector", dst.dim, a.dim)
+	}
+	for i := range dst.data {
+		dst.data[i] = a.data[i] * scalar
+	}
+
+	return nil
+}
+
+// Stores a + alpha * b into dst, without allocating.
+//
+// This is the classic "axpy" update of iterative methods.
+// The destination may be a or b itself.
+// Returns an error if the dimensions of a, b and dst differ.
+func AddScaledTo[T Float](dst, a *VectorOf[T], alpha T, b *VectorOf[T]) error {
+	if err := checkElementWise(dst, a, b, "add"); err != nil {
+		return err
+	}
+	for i := range dst.data {
+		dst.data[i] = a.data[i] + alpha*b.data[i]
+	}
+
+	return nil
+}
+
+// Copies the elements of src into dst.
+//
+// Returns an error if the dimensions of src and dst differ.
+func CopyTo[T Float](dst, src *VectorOf[T]) error {
+	if dst.dim != src.dim {
+		return fmt.Errorf("cannot copy vector of dimension %d into vector of dimension %d", src.dim, dst.dim)
+	}
+	copy(dst.data, src.data)
+
+	return nil
+}
+
+// Adds v2 to the calling vector, in place.
+//
+// Returns an error if the dimensions differ.
+func (v *VectorOf[T]) AddInPlace(v2 *VectorOf[T]) error {
+	return AddTo(v, v, v2)
+}
+
+// Subtracts v2 from the calling vector, in place.
+//
+// Returns an error if the dimensions differ.
+func (v *VectorOf[T]) SubInPlace(v2 *VectorOf[T]) error {
+	return SubTo(v, v, v2)
+}
+
+// Multiplies the calling vector by v2 element-wise, in place.
+//
+// Returns an error if the dimensions differ.
+func (v *VectorOf[T]) MulInPlace(v2 *VectorOf[T]) error {
+	return MulTo(v, v, v2)
+}
+
+// Divides the calling vector by v2 element-wise, in place.
+//
+// Returns an error if the dimensions differ.
+func (v *VectorOf[T]) DivInPlace(v2 *VectorOf[T]) error {
+	return DivTo(v, v, v2)
+}
+
+// Adds the given scalar value to each element of the calling vector, in place.
+func (v *VectorOf[T]) AddScalarInPlace(scalar T) {
+	for i := range v.data {
+		v.data[i] += scalar
+	}
+}
+
+// Subtracts the given scalar value from each element of the calling vector, in place.
+func (v *VectorOf[T]) SubScalarInPlace(scalar T) {
+	for i := range v.data {
+		v.data[i] -= scalar
+	}
+}
+
+// Multiplies each element of the calling vector by the given scalar value, in place.
+func (v *VectorOf[T]) MulScalarInPlace(scalar T) {
+	for i := range v.data {
+		v.data[i] *= scalar
+	}
+}
+
+// Divides each element of the calling vector by the given scalar value, in place.
+func (v *VectorOf[T]) DivScalarInPlace(scalar T) {
+	for i := range v.data {
+		v.data[i] /= scalar
+	}
+}
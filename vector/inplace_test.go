@@ -0,0 +1,109 @@
+package vector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddTo(t *testing.T) {
+	a := NewFromData([]float64{1, 2, 3})
+	b := NewFromData([]float64{4, 5, 6})
+	dst := New(3)
+
+	err := AddTo(dst, a, b)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{5, 7, 9}, dst.data)
+}
+
+func TestElementWiseTo_DestinationIsOperand(t *testing.T) {
+	a := NewFromData([]float64{6, 8})
+	b := NewFromData([]float64{2, 4})
+
+	assert.NoError(t, SubTo(b, a, b))
+	assert.Equal(t, []float64{4, 4}, b.data)
+	assert.NoError(t, MulTo(a, a, b))
+	assert.Equal(t, []float64{24, 32}, a.data)
+	assert.NoError(t, DivTo(a, a, a))
+	assert.Equal(t, []float64{1, 1}, a.data)
+}
+
+func TestElementWiseTo_ShouldFail_DimensionMismatch(t *testing.T) {
+	a := New(3)
+
+	errOperands := AddTo(New(3), a, New(2))
+	errDestination := MulTo(New(2), a, a)
+
+	assert.Error(t, errOperands)
+	assert.Contains(t, errOperands.Error(), "cannot add vectors of different dimensions")
+	assert.Error(t, errDestination)
+	assert.Contains(t, errDestination.Error(), "destination of dimension 2")
+}
+
+func TestMulScalarToAndAddScaledTo(t *testing.T) {
+	a := NewFromData([]float64{1, -2})
+	b := NewFromData([]float64{3, 1})
+	dst := New(2)
+
+	assert.NoError(t, MulScalarTo(dst, a, 3))
+	assert.Equal(t, []float64{3, -6}, dst.data)
+	assert.NoError(t, AddScaledTo(dst, a, 2, b))
+	assert.Equal(t, []float64{7, 0}, dst.data)
+	assert.Error(t, MulScalarTo(New(3), a, 1))
+	assert.Error(t, AddScaledTo(dst, a, 1, New(3)))
+}
+
+func TestCopyTo(t *testing.T) {
+	src := NewFromData([]float64{1, 2})
+	dst := New(2)
+
+	assert.NoError(t, CopyTo(dst, src))
+	assert.Equal(t, []float64{1, 2}, dst.data)
+	assert.Error(t, CopyTo(New(1), src))
+}
+
+func TestInPlaceOperations(t *testing.T) {
+	v := NewFromData([]float64{2, 4})
+	w := NewFromData([]float64{1, 2})
+	data := v.data
+
+	assert.NoError(t, v.AddInPlace(w))
+	assert.Equal(t, []float64{3, 6}, v.data)
+	assert.NoError(t, v.SubInPlace(w))
+	assert.Equal(t, []float64{2, 4}, v.data)
+	assert.NoError(t, v.MulInPlace(w))
+	assert.Equal(t, []float64{2, 8}, v.data)
+	assert.NoError(t, v.DivInPlace(w))
+	assert.Equal(t, []float64{2, 4}, v.data)
+	assert.Error(t, v.AddInPlace(New(3)))
+
+	// The storage is reused
+	assert.Same(t, &data[0], &v.data[0])
+}
+
+func TestScalarInPlaceOperations(t *testing.T) {
+	v := NewFromData([]float64{2, 4})
+
+	v.AddScalarInPlace(2)
+	assert.Equal(t, []float64{4, 6}, v.data)
+	v.SubScalarInPlace(1)
+	assert.Equal(t, []float64{3, 5}, v.data)
+	v.MulScalarInPlace(2)
+	assert.Equal(t, []float64{6, 10}, v.data)
+	v.DivScalarInPlace(2)
+	assert.Equal(t, []float64{3, 5}, v.data)
+}
+
+func TestInPlaceOperations_DoNotAllocate(t *testing.T) {
+	v := NewFromData([]float64{1, 2, 3})
+	w := NewFromData([]float64{4, 5, 6})
+
+	allocs := testing.AllocsPerRun(10, func() {
+		_ = v.AddInPlace(w)
+		_ = AddScaledTo(v, v, 0.5, w)
+		v.MulScalarInPlace(0.5)
+	})
+
+	assert.Equal(t, 0.0, allocs)
+}
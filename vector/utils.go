@@ -3,7 +3,8 @@
 // subtraction, scalar operations, norm calculation, normalization,
 // projection, and equality comparison.
 //
-// Vectors are represented as slices of float64 values, and all operations
+// Vectors are represented as slices of float64 or float32 values (Vector and
+// Vector32 are VectorOf[float64] and VectorOf[float32]), and all operations
 // are implemented to work on vectors of the same dimension, returning
 // errors when incompatible dimensions are encountered.
 //
@@ -24,22 +25,23 @@ import (
 	"math"
 )
 
-// Epsilon for floating-point comparison.
+// Epsilon for floating-point comparison of float64 elements,
+// scaled with Tolerance for other element types.
 const epsilon = 1e-9
 
 // Computes and returns the Euclidian distance between two vectors.
-func Distance(v1 *Vector, v2 *Vector) (float64, error) {
+func Distance[T Float](v1 *VectorOf[T], v2 *VectorOf[T]) (T, error) {
 	if v1.dim != v2.dim {
 		return 0.0, fmt.Errorf("cannot compute distance for vectors of different dimensions: %d vs %d", v1.dim, v2.dim)
 	}
 
-	var sumSquares float64
+	var sumSquares T
 	for i := 0; i < v1.dim; i++ {
 		diff := v1.data[i] - v2.data[i]
 		sumSquares += diff * diff
 	}
 
-	return math.Sqrt(sumSquares), nil
+	return T(math.Sqrt(float64(sumSquares))), nil
 }
 
 // Determines whether two vectors are colinear.
@@ -53,7 +55,7 @@ func Distance(v1 *Vector, v2 *Vector) (float64, error) {
 //   - If either vector is a zero vector, the vectors are considered colinear.
 //
 // Returns true if the vectors are colinear, false otherwise.
-func AreColinear(v1 *Vector, v2 *Vector) bool {
+func AreColinear[T Float](v1 *VectorOf[T], v2 *VectorOf[T]) bool {
 	if v1.dim == 0 && v2.dim == 0 {
 		// Considered colinear if norm is zero
 		return true
@@ -66,13 +68,14 @@ func AreColinear(v1 *Vector, v2 *Vector) bool {
 	}
 
 	// Check that it's the same factor (scalar) for every coordinate
+	tolerance := Tolerance[T](epsilon)
 	var referenceFactor *float64 = nil
 	for i := 0; i < v1.dim; i++ {
-		a := v1.data[i]
-		b := v2.data[i]
+		a := float64(v1.data[i])
+		b := float64(v2.data[i])
 
-		if math.Abs(b) < epsilon {
-			if math.Abs(a) >= epsilon {
+		if math.Abs(b) < tolerance {
+			if math.Abs(a) >= tolerance {
 				return false
 			}
 			// both are zero, still valid
@@ -82,7 +85,7 @@ func AreColinear(v1 *Vector, v2 *Vector) bool {
 		factor := a / b
 		if referenceFactor == nil {
 			referenceFactor = &factor
-		} else if math.Abs(*referenceFactor-factor) > epsilon {
+		} else if math.Abs(*referenceFactor-factor) > tolerance {
 			return false
 		}
 	}
@@ -96,11 +99,11 @@ func AreColinear(v1 *Vector, v2 *Vector) bool {
 // elements from the two vectors.
 //
 // Returns an error if the vectors have different dimensions.
-func DotProduct(v1 *Vector, v2 *Vector) (float64, error) {
+func DotProduct[T Float](v1 *VectorOf[T], v2 *VectorOf[T]) (T, error) {
 	if v1.dim != v2.dim {
 		return 0.0, fmt.Errorf("cannot compute dot products for vectors of different dimensions: %d vs %d", v1.dim, v2.dim)
 	}
-	sum := 0.0
+	var sum T
 	for i := 0; i < v1.dim; i++ {
 		sum += v1.data[i] * v2.data[i]
 	}
@@ -118,17 +121,17 @@ func DotProduct(v1 *Vector, v2 *Vector) (float64, error) {
 // will extrapolate accordingly.
 //
 // Returns a new interpolated vector or an error if the input vectors have different dimensions.
-func Lerp(v1 *Vector, v2 *Vector, t float64) (*Vector, error) {
+func Lerp[T Float](v1 *VectorOf[T], v2 *VectorOf[T], t T) (*VectorOf[T], error) {
 	if v1.dim != v2.dim {
 		return nil, fmt.Errorf("cannot compute linear interpolation for vectors of different dimensions: %d vs %d", v1.dim, v2.dim)
 	}
 
-	result := make([]float64, v1.dim)
+	result := NewOf[T](v1.dim)
 	for i := 0; i < v1.dim; i++ {
-		result[i] = v1.data[i]*(1-t) + v2.data[i]*t
+		result.data[i] = v1.data[i]*(1-t) + v2.data[i]*t
 	}
 
-	return NewFromData(result), nil
+	return result, nil
 }
 
 // Computes and returns the 2D cross product (aka the perp dot product) of two 2-dimensional vectors.
@@ -138,7 +141,7 @@ func Lerp(v1 *Vector, v2 *Vector, t float64) (*Vector, error) {
 //	x1*y2 - y1*x2
 //
 // Returns an error if the vectors do not have exactly 2 dimensions or if their dimensions differ.
-func Cross2D(v1 *Vector, v2 *Vector) (float64, error) {
+func Cross2D[T Float](v1 *VectorOf[T], v2 *VectorOf[T]) (T, error) {
 	if v1.dim != v2.dim {
 		return 0.0, fmt.Errorf("cannot perform cross2D operation for vectors of different dimensions: %d vs %d", v1.dim, v2.dim)
 	}
@@ -150,12 +153,12 @@ func Cross2D(v1 *Vector, v2 *Vector) (float64, error) {
 	return v1.data[0]*v2.data[1] - v1.data[1]*v2.data[0], nil
 }
 
-// Returns true if two float64 values are approximately equal,
+// Returns true if two floating-point values are approximately equal,
 // within a specified epsilon tolerance (constant variable at the start of this source file).
 //
 // This is used for comparing floating-point values while accounting for rounding errors.
-func almostEqual(a, b, epsilon float64) bool {
-	return math.Abs(a-b) <= epsilon
+func almostEqual[T Float](a, b, epsilon T) bool {
+	return math.Abs(float64(a-b)) <= float64(epsilon)
 }
 
 // Returns true if two vectors are approximately equal,
@@ -164,7 +167,7 @@ func almostEqual(a, b, epsilon float64) bool {
 //
 // Returns false if the vectors differ in dimension or any element differs
 // beyond the allowed epsilon.
-func vectorsAlmostEqual(v1, v2 *Vector, epsilon float64) bool {
+func vectorsAlmostEqual[T Float](v1, v2 *VectorOf[T], epsilon T) bool {
 	if v1.dim != v2.dim {
 		return false
 	}
@@ -182,7 +185,7 @@ func vectorsAlmostEqual(v1, v2 *Vector, epsilon float64) bool {
 //
 // Returns true if the vectors are orthogonal, false otherwise.
 // Returns false if the dot product cannot be computed due to a dimension mismatch.
-func AreOrthogonal(v1 *Vector, v2 *Vector) bool {
+func AreOrthogonal[T Float](v1 *VectorOf[T], v2 *VectorOf[T]) bool {
 	result, err := DotProduct(v1, v2)
 	if err != nil {
 		return false
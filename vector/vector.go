@@ -3,7 +3,8 @@
 // subtraction, scalar operations, norm calculation, normalization,
 // projection, and equality comparison.
 //
-// Vectors are represented as slices of float64 values, and all operations
+// Vectors are represented as slices of float64 or float32 values (Vector and
+// Vector32 are VectorOf[float64] and VectorOf[float32]), and all operations
 // are implemented to work on vectors of the same dimension, returning
 // errors when incompatible dimensions are encountered.
 //
@@ -11,9 +12,11 @@
 //   - Creation of new vectors with a given size or from existing data
 //   - Element-wise arithmetic operations (Add, Sub, Mul, Div)
 //   - Scalar operations on each component (AddScalar, SubScalar, etc.)
+//   - In-place and destination variants that do not allocate (AddInPlace, AddTo, etc.)
 //   - Computation of vector norm and normalization
 //   - Dot product and projection onto another vector
 //   - Basic utility checks like equality and zero-vector check
+//   - Complex vectors (CVector) with a conjugate-linear dot product
 //
 // This package can be very useful for numerical computations, simulations, physics,
 // game engines and machine learning tasks that require vector math with customizable dimensions.
@@ -25,14 +28,20 @@ import (
 	"strings"
 )
 
-// Vector type with dynamic size (dimension)
-type Vector struct {
-	data []float64
+// VectorOf type with dynamic size (dimension), holding elements of type T
+type VectorOf[T Float] struct {
+	data []T
 	dim  int
 }
 
+// Vector type with dynamic size (dimension), holding float64 elements
+type Vector = VectorOf[float64]
+
+// Vector32 type with dynamic size (dimension), holding float32 elements
+type Vector32 = VectorOf[float32]
+
 // String returns a human-readable string representation of the vector.
-func (v *Vector) String() string {
+func (v *VectorOf[T]) String() string {
 	if v.dim == 0 {
 		return "[]"
 	}
@@ -51,46 +60,67 @@ func (v *Vector) String() string {
 }
 
 // Get the data of the vector
-func (v *Vector) GetData() []float64 {
+func (v *VectorOf[T]) GetData() []T {
 	return v.data
 }
 
 // Get the dimension of the vector
-func (v *Vector) GetSize() int {
+func (v *VectorOf[T]) GetSize() int {
 	return v.dim
 }
 
 // Get the element at index idx
-func (v *Vector) GetElementAt(idx int) float64 {
+func (v *VectorOf[T]) GetElementAt(idx int) T {
 	return v.data[idx]
 }
 
 // Set the element called elt at index idx
-func (v *Vector) SetElementAt(idx int, elt float64) {
+func (v *VectorOf[T]) SetElementAt(idx int, elt T) {
 	v.data[idx] = elt
 }
 
 // Create a new vector of a given dimension
 func New(vSize int) *Vector {
-	return &Vector{data: make([]float64, vSize), dim: vSize}
+	return NewOf[float64](vSize)
 }
 
 // Create a new vector from a given slice (deep copy)
 func NewFromData(vData []float64) *Vector {
-	copiedVector := make([]float64, len(vData))
+	return NewFromDataOf(vData)
+}
+
+// Create a new vector of a given dimension, holding elements of type T
+func NewOf[T Float](vSize int) *VectorOf[T] {
+	return &VectorOf[T]{data: make([]T, vSize), dim: vSize}
+}
+
+// Create a new vector of elements of type T from a given slice (deep copy)
+func NewFromDataOf[T Float](vData []T) *VectorOf[T] {
+	copiedVector := make([]T, len(vData))
 	copy(copiedVector, vData)
 
-	return &Vector{
+	return &VectorOf[T]{
 		data: copiedVector,
 		dim:  len(copiedVector),
 	}
 }
 
+// Returns a copy of the vector v with its elements converted to type U,
+// for instance to go from float32 to float64 elements or conversely.
+func Convert[U, T Float](v *VectorOf[T]) *VectorOf[U] {
+	result := NewOf[U](v.dim)
+	for i, val := range v.data {
+		result.data[i] = U(val)
+	}
+
+	return result
+}
+
 // Tells if 2 vectors are "equal"
 //
 // Considered equal if they're of the same dimension
 // And contain the same elements in the same order
-func (v *Vector) Equals(v2 *Vector) bool {
+func (v *VectorOf[T]) Equals(v2 *VectorOf[T]) bool {
 	if v.dim != v2.dim {
 		return false
 	}
@@ -107,17 +137,17 @@ func (v *Vector) Equals(v2 *Vector) bool {
 //
 // Both vectors must have the same dimension. If their dimensions differ,
 // an error is returned.
-func (v *Vector) Add(v2 *Vector) (*Vector, error) {
+func (v *VectorOf[T]) Add(v2 *VectorOf[T]) (*VectorOf[T], error) {
 	if v.dim != v2.dim {
 		return nil, fmt.Errorf("cannot add vectors of different dimensions: %d vs %d", v.dim, v2.dim)
 	}
 
-	result := make([]float64, v.dim)
+	result := NewOf[T](v.dim)
 	for i := range v.data {
-		result[i] = v.data[i] + v2.data[i]
+		result.data[i] = v.data[i] + v2.data[i]
 	}
 
-	return NewFromData(result), nil
+	return result, nil
 }
 
 // Returns a new vector resulting from the element-wise subtraction
@@ -125,17 +155,17 @@ func (v *Vector) Add(v2 *Vector) (*Vector, error) {
 //
 // Both vectors must have the same dimension. If their dimensions differ,
 // an error is returned.
-func (v *Vector) Sub(v2 *Vector) (*Vector, error) {
+func (v *VectorOf[T]) Sub(v2 *VectorOf[T]) (*VectorOf[T], error) {
 	if v.dim != v2.dim {
 		return nil, fmt.Errorf("cannot sub vectors of different dimensions: %d vs %d", v.dim, v2.dim)
 	}
 
-	result := make([]float64, v.dim)
+	result := NewOf[T](v.dim)
 	for i := range v.data {
-		result[i] = v.data[i] - v2.data[i]
+		result.data[i] = v.data[i] - v2.data[i]
 	}
 
-	return NewFromData(result), nil
+	return result, nil
 }
 
 // Returns a new vector resulting from the element-wise
@@ -143,17 +173,17 @@ func (v *Vector) Sub(v2 *Vector) (*Vector, error) {
 //
 // Both vectors must have the same dimension. If their dimensions differ,
 // an error is returned.
-func (v *Vector) Mul(v2 *Vector) (*Vector, error) {
+func (v *VectorOf[T]) Mul(v2 *VectorOf[T]) (*VectorOf[T], error) {
 	if v.dim != v2.dim {
 		return nil, fmt.Errorf("cannot multiply vectors of different dimensions: %d vs %d", v.dim, v2.dim)
 	}
 
-	result := make([]float64, v.dim)
+	result := NewOf[T](v.dim)
 	for i := range v.data {
-		result[i] = v.data[i] * v2.data[i]
+		result.data[i] = v.data[i] * v2.data[i]
 	}
 
-	return NewFromData(result), nil
+	return result, nil
 }
 
 // Returns a new vector resulting from the element-wise division
@@ -161,23 +191,23 @@ func (v *Vector) Mul(v2 *Vector) (*Vector, error) {
 //
 // Both vectors must have the same dimension. If their dimensions differ,
 // an error is returned.
-func (v *Vector) Div(v2 *Vector) (*Vector, error) {
+func (v *VectorOf[T]) Div(v2 *VectorOf[T]) (*VectorOf[T], error) {
 	if v.dim != v2.dim {
 		return nil, fmt.Errorf("cannot divide vectors of different dimensions: %d vs %d", v.dim, v2.dim)
 	}
 
-	result := make([]float64, v.dim)
+	result := NewOf[T](v.dim)
 	for i := range v.data {
-		result[i] = v.data[i] / v2.data[i]
+		result.data[i] = v.data[i] / v2.data[i]
 	}
 
-	return NewFromData(result), nil
+	return result, nil
 }
 
 // Returns a new vector resulting from the element-wise addition
 // of the calling vector and the given scalar value.
-func (v *Vector) AddScalar(scalar float64) *Vector {
-	resultVector := NewFromData(v.data)
+func (v *VectorOf[T]) AddScalar(scalar T) *VectorOf[T] {
+	resultVector := NewFromDataOf(v.data)
 	for i := range v.data {
 		resultVector.data[i] += scalar
 	}
@@ -187,8 +217,8 @@ func (v *Vector) AddScalar(scalar float64) *Vector {
 
 // Returns a new vector resulting from the element-wise subtraction
 // of the given scalar value from the calling vector .
-func (v *Vector) SubScalar(scalar float64) *Vector {
-	resultVector := NewFromData(v.data)
+func (v *VectorOf[T]) SubScalar(scalar T) *VectorOf[T] {
+	resultVector := NewFromDataOf(v.data)
 	for i := range v.data {
 		resultVector.data[i] -= scalar
 	}
@@ -198,8 +228,8 @@ func (v *Vector) SubScalar(scalar float64) *Vector {
 
 // Returns a new vector resulting from the element-wise
 // multiplication of the calling vector and the given scalar value.
-func (v *Vector) MulScalar(scalar float64) *Vector {
-	resultVector := NewFromData(v.data)
+func (v *VectorOf[T]) MulScalar(scalar T) *VectorOf[T] {
+	resultVector := NewFromDataOf(v.data)
 	for i := range v.data {
 		resultVector.data[i] *= scalar
 	}
@@ -209,8 +239,8 @@ func (v *Vector) MulScalar(scalar float64) *Vector {
 
 // Returns a new vector resulting from the element-wise division
 // of the calling vector by the given scalar value.
-func (v *Vector) DivScalar(scalar float64) *Vector {
-	resultVector := NewFromData(v.data)
+func (v *VectorOf[T]) DivScalar(scalar T) *VectorOf[T] {
+	resultVector := NewFromDataOf(v.data)
 	for i := range v.data {
 		resultVector.data[i] /= scalar
 	}
@@ -219,28 +249,28 @@ func (v *Vector) DivScalar(scalar float64) *Vector {
 }
 
 // Computes and returns the norm (magnitude) of the calling vector
-func (v *Vector) Norm() float64 {
+func (v *VectorOf[T]) Norm() T {
 	if v.dim == 0 {
 		return 0.0
 	}
 
-	sumSquares := 0.0
+	var sumSquares T
 	for i := 0; i < v.dim; i++ {
 		sumSquares += v.data[i] * v.data[i]
 	}
 
-	return math.Sqrt(sumSquares)
+	return T(math.Sqrt(float64(sumSquares)))
 }
 
 // Returns a new vector resulting from normalization of the calling vector
 // (making it a unit vector).
-func (v *Vector) Normalize() *Vector {
+func (v *VectorOf[T]) Normalize() *VectorOf[T] {
 	norm := v.Norm()
 	return v.DivScalar(norm)
 }
 
 // Returns true if the calling vector is the zero vector
-func (v *Vector) IsZero() bool {
+func (v *VectorOf[T]) IsZero() bool {
 	for i := 0; i < v.dim; i++ {
 		if v.GetElementAt(i) != 0.0 {
 			return false
@@ -259,7 +289,7 @@ func (v *Vector) IsZero() bool {
 // This method requires that both vectors have the same dimension.
 // If the dimensions do not match, or if the target vector is the zero vector
 // (therefore has no defined direction), an error is returned.
-func (v *Vector) ProjectOnto(onto *Vector) (*Vector, error) {
+func (v *VectorOf[T]) ProjectOnto(onto *VectorOf[T]) (*VectorOf[T], error) {
 	if v.dim != onto.dim {
 		return nil, fmt.Errorf("cannot project vectors of different dimensions: %d vs %d", v.dim, onto.dim)
 	}